@@ -65,6 +65,18 @@ check also provides a number of shorthands for common cases:
     bool) checks that the value at query is present and satisfies the given
     predicate function; wantMsg is used in the resulting error if it fails.
 
+Wildcard queries have variants that validate every matching element, and fail
+if no elements match: check.ValidateAll, check.PredicateAll, check.EqualAll and
+check.NotEqualAll.
+
+Validators can be combined:
+
+  - check.AllOf(validators...) expects every validator to pass.
+  - check.AnyOf(validators...) expects at least one validator to pass.
+  - check.StableFor(validator, duration) expects the validator to pass and
+    the value to then remain unchanged for the duration, so a flapping value
+    fails the validation.
+
 These helpers all have prewritten validation functions that return sensible
 errors of the form "<path>: <got>, <want>", such as:
 
@@ -130,12 +142,38 @@ run because Await(ctx, client) aborts if the ctx has already expired, whereas
 AwaitUntil and AwaitFor will both be equivalent to Check if given a 0 or
 negative timeout or a deadline in the past.
 
+AllOf and AnyOf run their validators concurrently with the same deadline, so
+the above can also be written as
+
+	err := check.AllOf(
+		check.Equal(root.Some().Path(), someValue),
+		check.Present(root.Some().OtherPath()),
+	).AwaitFor(time.Second, client)
+
 # Error Messages
 
 The error messages generated by failing checks will include the path, the value
 at that path, and a description of what the validator wanted, e.g.
 
 	some/path: got 12, want 19
+
+# Validation reports
+
+A Collector records a structured Result for every Check and Await of the
+validators wrapped by its Record method: the path, the expected and last
+observed values, the timestamp of that value, how long the call waited, and
+whether it passed. fptest.RecordChecks returns a Collector that writes the
+results as JSON to the test outputs when the test ends:
+
+	checks := fptest.RecordChecks(t)
+	if err := checks.Record(check.Equal(query, want)).AwaitFor(time.Minute, client); err != nil {
+		t.Error(err)
+	}
+
+Errors from wildcard validators name each element that failed relative to the
+wildcard, e.g.
+
+	/some/list[name=*]/value: 1 of 3 elements failed: list[name=b]/value: got 12, want 19
 */
 package check
 
@@ -187,8 +225,11 @@ func FormatValue[T any](v *ygnmi.Value[T]) string {
 
 // FormatRelativePath formats a path relative to base.
 func FormatRelativePath(base, path ygnmi.PathStruct) string {
-	baseStr := FormatPath(base)
-	pathStr := FormatPath(path)
+	return formatRelative(FormatPath(base), FormatPath(path))
+}
+
+// formatRelative formats a path string relative to a base path string.
+func formatRelative(baseStr, pathStr string) string {
 	relStr, err := filepath.Rel(baseStr, pathStr)
 	if err != nil {
 		return pathStr
@@ -219,7 +260,7 @@ type Validator interface {
 // ended the await, and will frequently also have a validationErr (the error
 // generated by the most recent call to the validation function).
 type validationError[T any] struct {
-	query ygnmi.SingletonQuery[T]
+	query ygnmi.AnyQuery[T]
 	// validationErr is the error returned by the validation function.
 	validationErr error
 	// failureCause is the error that triggered this error. This will be nil if
@@ -276,6 +317,8 @@ func isTimeout(err error) bool {
 type validation[T any] struct {
 	query        ygnmi.SingletonQuery[T]
 	validationFn func(*ygnmi.Value[T]) error
+	// want describes the expected value, if known, for validation reports.
+	want string
 }
 
 var _ Validator = (*validation[any])(nil)
@@ -294,12 +337,18 @@ func (vd *validation[T]) RelPath(base ygnmi.PathStruct) string {
 // Check tests the validation condition immediately and returns an error if it
 // fails.
 func (vd *validation[T]) Check(client *ygnmi.Client) error {
+	return vd.check(client, nil)
+}
+
+// check is Check that records the value it fetched in obs.
+func (vd *validation[T]) check(client *ygnmi.Client, obs *observation) error {
 	lastVal, err := ygnmi.Lookup(context.Background(), client, vd.query)
 	if err != nil {
 		return &validationError[T]{
 			query:        vd.query,
 			failureCause: err}
 	}
+	observeValue(obs, lastVal)
 	if err := vd.validationFn(lastVal); err != nil {
 		return &validationError[T]{
 			query:         vd.query,
@@ -315,9 +364,14 @@ func (vd *validation[T]) Check(client *ygnmi.Client) error {
 // it from waiting for correct values if it has already received an incorrect
 // one.
 func (vd *validation[T]) Await(ctx context.Context, client *ygnmi.Client) error {
+	return vd.await(ctx, client, nil)
+}
+
+// await is Await that records the last value it received in obs.
+func (vd *validation[T]) await(ctx context.Context, client *ygnmi.Client, obs *observation) error {
 	// Do a plain check first, regardless of timeouts
 	var checkErr *validationError[T]
-	err := vd.Check(client)
+	err := vd.check(client, obs)
 	if err == nil || !errors.As(err, &checkErr) || checkErr.failureCause != nil {
 		// Either validation succeeded, or we couldn't fetch the value
 		return err
@@ -326,6 +380,7 @@ func (vd *validation[T]) Await(ctx context.Context, client *ygnmi.Client) error
 	// Watch until the context expires or we receive a valid value.
 	lastInvalid := checkErr.validationErr
 	watcher := ygnmi.Watch(ctx, client, vd.query, func(v *ygnmi.Value[T]) error {
+		observeValue(obs, v)
 		if lastInvalid = vd.validationFn(v); lastInvalid != nil {
 			return ygnmi.Continue
 		}
@@ -369,7 +424,7 @@ func (vd *validation[T]) AwaitUntil(deadline time.Time, client *ygnmi.Client) er
 
 // Validate expects validationFn to return no error on the query's value.
 func Validate[T any, QT ygnmi.SingletonQuery[T]](query QT, validationFn func(*ygnmi.Value[T]) error) Validator {
-	return &validation[T]{query, validationFn}
+	return &validation[T]{query: query, validationFn: validationFn}
 }
 
 // Predicate expects that the query has a value and the given predicate returns
@@ -378,13 +433,17 @@ func Validate[T any, QT ygnmi.SingletonQuery[T]](query QT, validationFn func(*yg
 //
 //	"/some/path: got 13, want a multiple of 4".
 func Predicate[T any, QT ygnmi.SingletonQuery[T]](query QT, wantMsg string, predicate func(T) bool) Validator {
-	return Validate(query, func(vgot *ygnmi.Value[T]) error {
-		got, present := vgot.Val()
-		if !present || !predicate(got) {
-			return fmt.Errorf("got %s, %s", FormatValue(vgot), wantMsg)
-		}
-		return nil
-	})
+	return &validation[T]{
+		query: query,
+		validationFn: func(vgot *ygnmi.Value[T]) error {
+			got, present := vgot.Val()
+			if !present || !predicate(got) {
+				return fmt.Errorf("got %s, %s", FormatValue(vgot), wantMsg)
+			}
+			return nil
+		},
+		want: wantMsg,
+	}
 }
 
 // Equal expects the query's value to be want.
@@ -403,13 +462,17 @@ func NotEqual[T any, QT ygnmi.SingletonQuery[T]](query QT, wantNot T) Validator
 
 // EqualOrNil expects the query to be unset or have value want.
 func EqualOrNil[T any, QT ygnmi.SingletonQuery[T]](query QT, want T) Validator {
-	return Validate(query, func(vgot *ygnmi.Value[T]) error {
-		got, present := vgot.Val()
-		if present && !reflect.DeepEqual(got, want) {
-			return fmt.Errorf("got %s, want %#v or no value", FormatValue(vgot), want)
-		}
-		return nil
-	})
+	return &validation[T]{
+		query: query,
+		validationFn: func(vgot *ygnmi.Value[T]) error {
+			got, present := vgot.Val()
+			if present && !reflect.DeepEqual(got, want) {
+				return fmt.Errorf("got %s, want %#v or no value", FormatValue(vgot), want)
+			}
+			return nil
+		},
+		want: fmt.Sprintf("want %#v or no value", want),
+	}
 }
 
 // Present expects the query to have any value.
@@ -421,12 +484,16 @@ func Present[T any, QT ygnmi.SingletonQuery[T]](query QT) Validator {
 
 // NotPresent expects the query to not have a value set.
 func NotPresent[T any, QT ygnmi.SingletonQuery[T]](query QT) Validator {
-	return Validate(query, func(vgot *ygnmi.Value[T]) error {
-		if vgot.IsPresent() {
-			return fmt.Errorf("got %s, want no value", FormatValue(vgot))
-		}
-		return nil
-	})
+	return &validation[T]{
+		query: query,
+		validationFn: func(vgot *ygnmi.Value[T]) error {
+			if vgot.IsPresent() {
+				return fmt.Errorf("got %s, want no value", FormatValue(vgot))
+			}
+			return nil
+		},
+		want: "want no value",
+	}
 }
 
 // UnorderedEqual function is used to compare slices of type T in unordered way.
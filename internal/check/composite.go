@@ -0,0 +1,341 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openconfig/ygnmi/ygnmi"
+)
+
+// awaitFor calls vd.Await with a context with deadline now + timeout. If timeout
+// is <= 0, this is equivalent to vd.Check().
+func awaitFor(vd Validator, timeout time.Duration, client *ygnmi.Client) error {
+	if timeout <= 0 {
+		return vd.Check(client)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return vd.Await(ctx, client)
+}
+
+// awaitUntil calls vd.Await with a context with the given deadline. If deadline
+// is in the past, this is equivalent to vd.Check().
+func awaitUntil(vd Validator, deadline time.Time, client *ygnmi.Client) error {
+	if deadline.Before(time.Now()) {
+		return vd.Check(client)
+	}
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	return vd.Await(ctx, client)
+}
+
+// forEach calls fn on every validator concurrently and returns the results in the
+// order of the validators.
+func forEach(vds []Validator, fn func(Validator) error) []error {
+	errs := make([]error, len(vds))
+	var wg sync.WaitGroup
+	for i, vd := range vds {
+		wg.Add(1)
+		go func(i int, vd Validator) {
+			defer wg.Done()
+			errs[i] = fn(vd)
+		}(i, vd)
+	}
+	wg.Wait()
+	return errs
+}
+
+// joinPaths formats the paths of a composite validator.
+func joinPaths(op string, vds []Validator, path func(Validator) string) string {
+	paths := make([]string, len(vds))
+	for i, vd := range vds {
+		paths[i] = path(vd)
+	}
+	return fmt.Sprintf("%s(%s)", op, strings.Join(paths, ", "))
+}
+
+// allOf is the Validator returned by AllOf.
+type allOf struct {
+	vds []Validator
+}
+
+var _ Validator = (*allOf)(nil)
+
+// AllOf expects every one of the validators to pass. Await waits for all of them
+// concurrently with the same deadline, so on a failing device the total wait is
+// the timeout rather than the timeout per validator. The error lists every
+// validator that failed.
+func AllOf(vds ...Validator) Validator {
+	return &allOf{vds: vds}
+}
+
+// Path returns a string representation of the paths being validated.
+func (a *allOf) Path() string {
+	return joinPaths("all of", a.vds, Validator.Path)
+}
+
+// RelPath returns a string representation of the paths being validated, relative
+// to some base.
+func (a *allOf) RelPath(base ygnmi.PathStruct) string {
+	return joinPaths("all of", a.vds, func(vd Validator) string { return vd.RelPath(base) })
+}
+
+// Check tests every validation condition immediately.
+func (a *allOf) Check(client *ygnmi.Client) error {
+	return errors.Join(forEach(a.vds, func(vd Validator) error { return vd.Check(client) })...)
+}
+
+// Await waits for every validation condition to pass.
+func (a *allOf) Await(ctx context.Context, client *ygnmi.Client) error {
+	return errors.Join(forEach(a.vds, func(vd Validator) error { return vd.Await(ctx, client) })...)
+}
+
+// AwaitFor calls Await with a context with deadline now + timeout.
+func (a *allOf) AwaitFor(timeout time.Duration, client *ygnmi.Client) error {
+	return awaitFor(a, timeout, client)
+}
+
+// AwaitUntil calls Await with a context with the given deadline.
+func (a *allOf) AwaitUntil(deadline time.Time, client *ygnmi.Client) error {
+	return awaitUntil(a, deadline, client)
+}
+
+// hold expects every validator to hold its passing value until the context ends.
+func (a *allOf) hold(ctx context.Context, client *ygnmi.Client) error {
+	return errors.Join(forEach(a.vds, func(vd Validator) error { return holdValidator(ctx, vd, client) })...)
+}
+
+// anyOf is the Validator returned by AnyOf.
+type anyOf struct {
+	vds []Validator
+}
+
+var _ Validator = (*anyOf)(nil)
+
+// AnyOf expects at least one of the validators to pass. Await waits for all of
+// them concurrently with the same deadline and returns as soon as one passes.
+// The error lists every validator that failed.
+func AnyOf(vds ...Validator) Validator {
+	return &anyOf{vds: vds}
+}
+
+// Path returns a string representation of the paths being validated.
+func (a *anyOf) Path() string {
+	return joinPaths("any of", a.vds, Validator.Path)
+}
+
+// RelPath returns a string representation of the paths being validated, relative
+// to some base.
+func (a *anyOf) RelPath(base ygnmi.PathStruct) string {
+	return joinPaths("any of", a.vds, func(vd Validator) string { return vd.RelPath(base) })
+}
+
+// anyPassed returns nil if any of the errors is nil, or all of them otherwise.
+func anyPassed(errs []error) error {
+	for _, err := range errs {
+		if err == nil {
+			return nil
+		}
+	}
+	if len(errs) == 0 {
+		return errors.New("any of no validators: nothing to pass")
+	}
+	return fmt.Errorf("none passed:\n%w", errors.Join(errs...))
+}
+
+// Check tests every validation condition immediately.
+func (a *anyOf) Check(client *ygnmi.Client) error {
+	return anyPassed(forEach(a.vds, func(vd Validator) error { return vd.Check(client) }))
+}
+
+// Await waits for any validation condition to pass, and stops waiting for the
+// others when it does.
+func (a *anyOf) Await(ctx context.Context, client *ygnmi.Client) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	return anyPassed(forEach(a.vds, func(vd Validator) error {
+		err := vd.Await(ctx, client)
+		if err == nil {
+			cancel()
+		}
+		return err
+	}))
+}
+
+// AwaitFor calls Await with a context with deadline now + timeout.
+func (a *anyOf) AwaitFor(timeout time.Duration, client *ygnmi.Client) error {
+	return awaitFor(a, timeout, client)
+}
+
+// AwaitUntil calls Await with a context with the given deadline.
+func (a *anyOf) AwaitUntil(deadline time.Time, client *ygnmi.Client) error {
+	return awaitUntil(a, deadline, client)
+}
+
+// hold expects any validator to hold its passing value until the context ends.
+func (a *anyOf) hold(ctx context.Context, client *ygnmi.Client) error {
+	return anyPassed(forEach(a.vds, func(vd Validator) error { return holdValidator(ctx, vd, client) }))
+}
+
+// holder is implemented by validators that support StableFor.
+type holder interface {
+	// hold watches the validated values until the context ends, and returns an
+	// error if any of them changes or stops passing validation. Reaching the end
+	// of the context is not an error.
+	hold(context.Context, *ygnmi.Client) error
+}
+
+// holdValidator calls hold on vd, or returns an error if vd does not support it.
+func holdValidator(ctx context.Context, vd Validator, client *ygnmi.Client) error {
+	h, ok := vd.(holder)
+	if !ok {
+		return fmt.Errorf("%s: %T does not support StableFor", vd.Path(), vd)
+	}
+	return h.hold(ctx, client)
+}
+
+// stable is the Validator returned by StableFor.
+type stable struct {
+	vd       Validator
+	duration time.Duration
+}
+
+var _ Validator = (*stable)(nil)
+
+// StableFor expects vd to pass and then keep the same value for the given
+// duration. Any change of the value during that time fails the validation, even
+// if the new value would also pass, so a flapping value is caught. Once vd
+// passes, Await and Check both wait for the full duration regardless of their
+// deadline, e.g.
+//
+//	check.StableFor(check.Equal(ocpath.Some().OperStatus().State(), UP), 10*time.Second).AwaitFor(time.Minute, client)
+//
+// waits up to a minute for the status to become UP, then expects it to remain UP
+// for ten more seconds.
+//
+// vd may be any Validator created by this package, including AllOf and AnyOf.
+func StableFor(vd Validator, duration time.Duration) Validator {
+	return &stable{vd: vd, duration: duration}
+}
+
+// Path returns a string representation of the path being validated.
+func (s *stable) Path() string {
+	return s.vd.Path()
+}
+
+// RelPath returns a string representation of the path being validated, relative
+// to some base.
+func (s *stable) RelPath(base ygnmi.PathStruct) string {
+	return s.vd.RelPath(base)
+}
+
+// hold expects the validated value to be stable until the context ends.
+func (s *stable) hold(ctx context.Context, client *ygnmi.Client) error {
+	return holdValidator(ctx, s.vd, client)
+}
+
+// holdFor expects the validated value to be stable for the duration.
+func (s *stable) holdFor(client *ygnmi.Client) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.duration)
+	defer cancel()
+	return s.hold(ctx, client)
+}
+
+// Check tests the validation condition immediately, then expects it to remain
+// unchanged for the duration.
+func (s *stable) Check(client *ygnmi.Client) error {
+	return s.checkObserved(client, nil)
+}
+
+// Await waits for the validation condition to pass, then expects it to remain
+// unchanged for the duration.
+func (s *stable) Await(ctx context.Context, client *ygnmi.Client) error {
+	return s.awaitObserved(ctx, client, nil)
+}
+
+// AwaitFor calls Await with a context with deadline now + timeout.
+func (s *stable) AwaitFor(timeout time.Duration, client *ygnmi.Client) error {
+	return awaitFor(s, timeout, client)
+}
+
+// AwaitUntil calls Await with a context with the given deadline.
+func (s *stable) AwaitUntil(deadline time.Time, client *ygnmi.Client) error {
+	return awaitUntil(s, deadline, client)
+}
+
+// sameValue returns true if both values are absent or have equal values.
+func sameValue[T any](a, b *ygnmi.Value[T]) bool {
+	aVal, aPresent := a.Val()
+	bVal, bPresent := b.Val()
+	return aPresent == bPresent && reflect.DeepEqual(aVal, bVal)
+}
+
+// holdValue is called with each value received while holding; it returns an
+// error if the value fails validation or is not the same as the first value.
+func holdValue[T any](first **ygnmi.Value[T], v *ygnmi.Value[T], validationFn func(*ygnmi.Value[T]) error, start time.Time) error {
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err := validationFn(v); err != nil {
+		return fmt.Errorf("not stable: %w after %v", err, elapsed)
+	}
+	if *first == nil {
+		*first = v
+		return nil
+	}
+	if !sameValue(*first, v) {
+		return fmt.Errorf("not stable: changed from %s to %s after %v", FormatValue(*first), FormatValue(v), elapsed)
+	}
+	return nil
+}
+
+// endHold returns the result of holding a value, given the error that ended the
+// watch and the error that the value failed with, if any.
+func endHold[T any](query ygnmi.AnyQuery[T], watchErr, holdErr error) error {
+	switch {
+	case holdErr != nil:
+		return &validationError[T]{
+			query:         query,
+			validationErr: holdErr,
+		}
+	case watchErr == nil, isTimeout(watchErr):
+		return nil
+	}
+	return &validationError[T]{
+		query:        query,
+		failureCause: watchErr,
+	}
+}
+
+// hold watches the query until the context ends, and fails if the value changes
+// or stops passing validation.
+func (vd *validation[T]) hold(ctx context.Context, client *ygnmi.Client) error {
+	start := time.Now()
+	var first *ygnmi.Value[T]
+	var holdErr error
+	watcher := ygnmi.Watch(ctx, client, vd.query, func(v *ygnmi.Value[T]) error {
+		if holdErr = holdValue(&first, v, vd.validationFn, start); holdErr != nil {
+			return holdErr
+		}
+		return ygnmi.Continue
+	})
+	_, err := watcher.Await()
+	return endHold(vd.query, err, holdErr)
+}
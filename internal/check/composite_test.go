@@ -0,0 +1,184 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package check_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openconfig/featureprofiles/internal/check"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygnmi/exampleoc/exampleocpath"
+	"github.com/openconfig/ygnmi/ygnmi"
+)
+
+var (
+	singleKeyValues   = exampleocpath.Root().Model().SingleKeyAny().Value()
+	singleKeyWildPath = "/model/a/single-key[key=*]/state/value"
+)
+
+// keyUpdate represents a notification of the values of
+// /model/a/single-key[key=<key>]/state/value by key, and its delay.
+type keyUpdate struct {
+	values map[string]int64
+	delay  time.Duration
+}
+
+// stubSingleKeys clears the fakeGNMI's stub and populates it with a
+// notification for each update.
+func (fg *fakeGNMI) stubSingleKeys(t *testing.T, updates ...keyUpdate) {
+	t.Helper()
+	fg.gen.Reset()
+	for _, u := range updates {
+		n := &gpb.Notification{Timestamp: int64(u.delay)}
+		for key, value := range u.values {
+			path, _, err := ygnmi.ResolvePath(exampleocpath.Root().Model().SingleKey(key).Value().State().PathStruct())
+			if err != nil {
+				t.Fatalf("Resolving OC path: %v", err)
+			}
+			n.Update = append(n.Update, &gpb.Update{
+				Path: path,
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: value}},
+			})
+		}
+		fg.gen.Responses = append(fg.gen.Responses,
+			&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: n}},
+			&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_SyncResponse{SyncResponse: true}},
+		)
+	}
+}
+
+func TestComposite(t *testing.T) {
+	query := childTwo.State()
+	testCases := []struct {
+		desc        string
+		validator   check.Validator
+		updates     []update
+		errIncludes []string
+	}{{
+		desc:      "AllOf/Correct",
+		validator: check.AllOf(check.Equal(query, "correct"), check.Present[string](query)),
+		updates:   []update{{"correct", 0}},
+	}, {
+		desc:        "AllOf/Incorrect",
+		validator:   check.AllOf(check.Equal(query, "correct"), check.Equal(query, "other")),
+		updates:     []update{{"correct", 0}, {"correct", time.Hour}},
+		errIncludes: []string{childTwoStatePath, `want "other"`},
+	}, {
+		desc:      "AllOf/Delayed correct",
+		validator: check.AllOf(check.Equal(query, "correct"), check.Present[string](query)),
+		updates:   []update{{"wrong", 0}, {"correct", 0}},
+	}, {
+		desc:      "AnyOf/Correct",
+		validator: check.AnyOf(check.Equal(query, "other"), check.Equal(query, "correct")),
+		updates:   []update{{"correct", 0}},
+	}, {
+		desc:        "AnyOf/Incorrect",
+		validator:   check.AnyOf(check.Equal(query, "other"), check.Equal(query, "correct")),
+		updates:     []update{{"wrong", 0}, {"wrong", time.Hour}},
+		errIncludes: []string{"none passed", `want "other"`, `want "correct"`, "wrong"},
+	}, {
+		desc:      "StableFor/Stable",
+		validator: check.StableFor(check.Equal(query, "correct"), 200*time.Millisecond),
+		updates:   []update{{"correct", 0}, {"correct", time.Hour}},
+	}, {
+		desc:        "StableFor/Flapping",
+		validator:   check.StableFor(check.Equal(query, "correct"), time.Second),
+		updates:     []update{{"correct", 0}, {"wrong", 50 * time.Millisecond}, {"correct", time.Hour}},
+		errIncludes: []string{childTwoStatePath, "not stable", "wrong"},
+	}, {
+		desc:        "StableFor/Changed",
+		validator:   check.StableFor(check.NotEqual(query, "bad"), time.Second),
+		updates:     []update{{"first", 0}, {"second", 50 * time.Millisecond}, {"first", time.Hour}},
+		errIncludes: []string{childTwoStatePath, "not stable", `changed from "first" to "second"`},
+	}, {
+		desc:      "StableFor/AllOf",
+		validator: check.StableFor(check.AllOf(check.Equal(query, "correct"), check.Present[string](query)), 200*time.Millisecond),
+		updates:   []update{{"correct", 0}, {"correct", time.Hour}},
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Composite validators may leave watches running, so each case gets its
+			// own agent rather than resetting a shared stub.
+			fakeGNMI, c := mustNewFakeGNMI(context.Background(), t)
+			defer fakeGNMI.Close()
+			fakeGNMI.stubChildTwo(tc.updates...)
+			gotErr := tc.validator.AwaitFor(time.Millisecond*500, c)
+			if len(tc.errIncludes) > 0 {
+				if err := errContainsAll(gotErr, tc.errIncludes); err != nil {
+					t.Error(err)
+				}
+			} else if gotErr != nil {
+				t.Errorf("Unexpected error: %v", gotErr)
+			}
+		})
+	}
+}
+
+func TestWildcard(t *testing.T) {
+	query := singleKeyValues.State()
+	testCases := []struct {
+		desc        string
+		validator   check.Validator
+		updates     []keyUpdate
+		errIncludes []string
+	}{{
+		desc:      "EqualAll/Correct",
+		validator: check.EqualAll(query, 1),
+		updates:   []keyUpdate{{map[string]int64{"k1": 1, "k2": 1}, 0}},
+	}, {
+		desc:        "EqualAll/Incorrect",
+		validator:   check.EqualAll(query, 1),
+		updates:     []keyUpdate{{map[string]int64{"k1": 1, "k2": 2}, 0}, {map[string]int64{"k1": 1}, time.Hour}},
+		errIncludes: []string{singleKeyWildPath, "1 of 2 elements failed", "single-key[key=k2]/state/value: got 2, want 1"},
+	}, {
+		desc:        "EqualAll/No elements",
+		validator:   check.EqualAll(query, 1),
+		updates:     []keyUpdate{{nil, 0}, {map[string]int64{"k1": 1}, time.Hour}},
+		errIncludes: []string{singleKeyWildPath, "no matching elements", "deadline"},
+	}, {
+		desc:      "PredicateAll/Correct",
+		validator: check.PredicateAll(query, "want positive", func(v int64) bool { return v > 0 }),
+		updates:   []keyUpdate{{map[string]int64{"k1": 1, "k2": 2}, 0}},
+	}, {
+		desc:      "NotEqualAll/Delayed correct",
+		validator: check.NotEqualAll(query, 0),
+		updates:   []keyUpdate{{map[string]int64{"k1": 1, "k2": 0}, 0}, {map[string]int64{"k2": 2}, 0}},
+	}, {
+		desc:      "StableFor/Stable",
+		validator: check.StableFor(check.EqualAll(query, 1), 200*time.Millisecond),
+		updates:   []keyUpdate{{map[string]int64{"k1": 1, "k2": 1}, 0}, {map[string]int64{"k1": 1}, time.Hour}},
+	}, {
+		desc:        "StableFor/Flapping",
+		validator:   check.StableFor(check.EqualAll(query, 1), time.Second),
+		updates:     []keyUpdate{{map[string]int64{"k1": 1, "k2": 1}, 0}, {map[string]int64{"k2": 3}, 50 * time.Millisecond}, {map[string]int64{"k1": 1}, time.Hour}},
+		errIncludes: []string{singleKeyWildPath, "single-key[key=k2]/state/value: not stable", "got 3"},
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			fakeGNMI, c := mustNewFakeGNMI(context.Background(), t)
+			defer fakeGNMI.Close()
+			fakeGNMI.stubSingleKeys(t, tc.updates...)
+			gotErr := tc.validator.AwaitFor(time.Millisecond*500, c)
+			if len(tc.errIncludes) > 0 {
+				if err := errContainsAll(gotErr, tc.errIncludes); err != nil {
+					t.Error(err)
+				}
+			} else if gotErr != nil {
+				t.Errorf("Unexpected error: %v", gotErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/openconfig/ygnmi/ygnmi"
+)
+
+// Result is the structured outcome of a recorded Check or Await.
+type Result struct {
+	// Path is the path being validated.
+	Path string `json:"path"`
+	// Call is "Check" or "Await"; AwaitFor and AwaitUntil are recorded as Await,
+	// or as Check if they did not wait.
+	Call string `json:"call"`
+	// Want describes the expected value, if the validator knows it.
+	Want string `json:"want,omitempty"`
+	// Got is the last value observed, if any value was fetched.
+	Got string `json:"got,omitempty"`
+	// Timestamp is the timestamp of the last value observed.
+	Timestamp time.Time `json:"timestamp,omitzero"`
+	// Start is when the call started.
+	Start time.Time `json:"start"`
+	// Waited is how long the call took.
+	Waited time.Duration `json:"waited_ns"`
+	// Passed is true if the validation passed.
+	Passed bool `json:"passed"`
+	// Error is the validation error, if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// observation holds the last value fetched by a validation.
+type observation struct {
+	mu        sync.Mutex
+	got       string
+	timestamp time.Time
+}
+
+// observe records a fetched value; it is a no-op on a nil observation.
+func (o *observation) observe(got string, timestamp time.Time) {
+	if o == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.got = got
+	o.timestamp = timestamp
+}
+
+// observeValue records a fetched value in obs, which may be nil.
+func observeValue[T any](obs *observation, v *ygnmi.Value[T]) {
+	if obs == nil || v == nil {
+		return
+	}
+	obs.observe(FormatValue(v), v.Timestamp)
+}
+
+// observable is implemented by validators that can report the values they fetch.
+type observable interface {
+	checkObserved(*ygnmi.Client, *observation) error
+	awaitObserved(context.Context, *ygnmi.Client, *observation) error
+	wantMsg() string
+}
+
+func (vd *validation[T]) checkObserved(client *ygnmi.Client, obs *observation) error {
+	return vd.check(client, obs)
+}
+
+func (vd *validation[T]) awaitObserved(ctx context.Context, client *ygnmi.Client, obs *observation) error {
+	return vd.await(ctx, client, obs)
+}
+
+func (vd *validation[T]) wantMsg() string {
+	return vd.want
+}
+
+func (vd *wildcardValidation[T]) checkObserved(client *ygnmi.Client, obs *observation) error {
+	_, err := vd.check(client, obs)
+	return err
+}
+
+func (vd *wildcardValidation[T]) awaitObserved(ctx context.Context, client *ygnmi.Client, obs *observation) error {
+	return vd.await(ctx, client, obs)
+}
+
+func (vd *wildcardValidation[T]) wantMsg() string {
+	return vd.want
+}
+
+func (s *stable) checkObserved(client *ygnmi.Client, obs *observation) error {
+	if err := checkObserved(s.vd, client, obs); err != nil {
+		return err
+	}
+	return s.holdFor(client)
+}
+
+func (s *stable) awaitObserved(ctx context.Context, client *ygnmi.Client, obs *observation) error {
+	if err := awaitObserved(ctx, s.vd, client, obs); err != nil {
+		return err
+	}
+	return s.holdFor(client)
+}
+
+func (s *stable) wantMsg() string {
+	if w := wantMsg(s.vd); w != "" {
+		return fmt.Sprintf("%s, stable for %v", w, s.duration)
+	}
+	return fmt.Sprintf("stable for %v", s.duration)
+}
+
+// checkObserved calls vd.Check, recording the value it fetches if vd supports it.
+func checkObserved(vd Validator, client *ygnmi.Client, obs *observation) error {
+	if o, ok := vd.(observable); ok {
+		return o.checkObserved(client, obs)
+	}
+	return vd.Check(client)
+}
+
+// awaitObserved calls vd.Await, recording the values it fetches if vd supports it.
+func awaitObserved(ctx context.Context, vd Validator, client *ygnmi.Client, obs *observation) error {
+	if o, ok := vd.(observable); ok {
+		return o.awaitObserved(ctx, client, obs)
+	}
+	return vd.Await(ctx, client)
+}
+
+// wantMsg returns what vd expects, or "" if it is not known.
+func wantMsg(vd Validator) string {
+	if o, ok := vd.(observable); ok {
+		return o.wantMsg()
+	}
+	return ""
+}
+
+// Collector collects the results of the validators it records. It is safe for
+// concurrent use. fptest.RecordChecks returns a Collector that writes the
+// results to the test outputs when the test ends.
+type Collector struct {
+	mu      sync.Mutex
+	results []Result
+}
+
+// NewCollector returns an empty Collector.
+func NewCollector() *Collector {
+	return &Collector{}
+}
+
+// Record returns a Validator that behaves like vd, but also adds a Result to the
+// collector for every Check and Await. The validators of AllOf and AnyOf are
+// recorded individually.
+func (c *Collector) Record(vd Validator) Validator {
+	switch v := vd.(type) {
+	case *allOf:
+		return &allOf{vds: c.recordAll(v.vds)}
+	case *anyOf:
+		return &anyOf{vds: c.recordAll(v.vds)}
+	}
+	return &recorded{vd: vd, c: c}
+}
+
+func (c *Collector) recordAll(vds []Validator) []Validator {
+	recs := make([]Validator, len(vds))
+	for i, vd := range vds {
+		recs[i] = c.Record(vd)
+	}
+	return recs
+}
+
+func (c *Collector) add(r Result) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.results = append(c.results, r)
+}
+
+// Results returns the results collected so far, in the order the calls ended.
+func (c *Collector) Results() []Result {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Result(nil), c.results...)
+}
+
+// WriteJSON writes the results collected so far as a JSON array.
+func (c *Collector) WriteJSON(w io.Writer) error {
+	results := c.Results()
+	if results == nil {
+		results = []Result{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(results)
+}
+
+// recorded is the Validator returned by Collector.Record.
+type recorded struct {
+	vd Validator
+	c  *Collector
+}
+
+var _ Validator = (*recorded)(nil)
+
+// Path returns a string representation of the path being validated.
+func (r *recorded) Path() string {
+	return r.vd.Path()
+}
+
+// RelPath returns a string representation of the path being validated,
+// relative to some base.
+func (r *recorded) RelPath(base ygnmi.PathStruct) string {
+	return r.vd.RelPath(base)
+}
+
+// record adds the result of a call to the collector.
+func (r *recorded) record(call string, start time.Time, obs *observation, err error) error {
+	res := Result{
+		Path:      r.vd.Path(),
+		Call:      call,
+		Want:      wantMsg(r.vd),
+		Got:       obs.got,
+		Timestamp: obs.timestamp,
+		Start:     start,
+		Waited:    time.Since(start),
+		Passed:    err == nil,
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	r.c.add(res)
+	return err
+}
+
+// Check calls Check on the recorded validator and records the result.
+func (r *recorded) Check(client *ygnmi.Client) error {
+	start := time.Now()
+	obs := &observation{}
+	err := checkObserved(r.vd, client, obs)
+	return r.record("Check", start, obs, err)
+}
+
+// Await calls Await on the recorded validator and records the result.
+func (r *recorded) Await(ctx context.Context, client *ygnmi.Client) error {
+	start := time.Now()
+	obs := &observation{}
+	err := awaitObserved(ctx, r.vd, client, obs)
+	return r.record("Await", start, obs, err)
+}
+
+// AwaitFor calls Await with a context with deadline now + timeout.
+func (r *recorded) AwaitFor(timeout time.Duration, client *ygnmi.Client) error {
+	return awaitFor(r, timeout, client)
+}
+
+// AwaitUntil calls Await with a context with the given deadline.
+func (r *recorded) AwaitUntil(deadline time.Time, client *ygnmi.Client) error {
+	return awaitUntil(r, deadline, client)
+}
+
+// hold lets a recorded validator be used with StableFor.
+func (r *recorded) hold(ctx context.Context, client *ygnmi.Client) error {
+	return holdValidator(ctx, r.vd, client)
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package check_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/openconfig/featureprofiles/internal/check"
+)
+
+func TestCollector(t *testing.T) {
+	fakeGNMI, c := mustNewFakeGNMI(context.Background(), t)
+	defer fakeGNMI.Close()
+	query := childTwo.State()
+	collector := check.NewCollector()
+
+	fakeGNMI.stubChildTwo(update{"correct", 0})
+	if err := collector.Record(check.Equal(query, "correct")).Check(c); err != nil {
+		t.Errorf("Check() unexpected error: %v", err)
+	}
+	fakeGNMI.stubChildTwo(update{"wrong", 0}, update{"wrong", time.Hour})
+	if err := collector.Record(check.Equal(query, "correct")).AwaitFor(100*time.Millisecond, c); err == nil {
+		t.Errorf("AwaitFor() got no error, want error")
+	}
+	fakeGNMI.stubChildTwo(update{"correct", 0})
+	if err := collector.Record(check.AllOf(check.Present[string](query), check.NotPresent[string](query))).Check(c); err == nil {
+		t.Errorf("AllOf().Check() got no error, want error")
+	}
+
+	got := collector.Results()
+	want := []check.Result{{
+		Path:   childTwoStatePath,
+		Call:   "Check",
+		Want:   `want "correct"`,
+		Got:    `"correct"`,
+		Passed: true,
+	}, {
+		Path:  childTwoStatePath,
+		Call:  "Await",
+		Want:  `want "correct"`,
+		Got:   `"wrong"`,
+		Error: `/parent/child/state/two: got "wrong", want "correct" (deadline exceeded)`,
+	}, {
+		Path:   childTwoStatePath,
+		Call:   "Check",
+		Want:   "want any value",
+		Got:    `"correct"`,
+		Passed: true,
+	}, {
+		Path:  childTwoStatePath,
+		Call:  "Check",
+		Want:  "want no value",
+		Got:   `"correct"`,
+		Error: `/parent/child/state/two: got "correct", want no value`,
+	}}
+	if len(got) != len(want) {
+		t.Fatalf("Results() got %d results, want %d: %v", len(got), len(want), got)
+	}
+	ignore := cmpopts.IgnoreFields(check.Result{}, "Timestamp", "Start", "Waited")
+	if diff := cmp.Diff(want[:2], got[:2], ignore); diff != "" {
+		t.Errorf("Results() unexpected diff (-want,+got):\n%s", diff)
+	}
+	// The validators of AllOf run concurrently, so their order is not fixed.
+	sortResults := cmpopts.SortSlices(func(a, b check.Result) bool { return a.Want < b.Want })
+	if diff := cmp.Diff(want[2:], got[2:], ignore, sortResults); diff != "" {
+		t.Errorf("Results() of AllOf unexpected diff (-want,+got):\n%s", diff)
+	}
+	if got[1].Waited < 100*time.Millisecond {
+		t.Errorf("Results() Await waited %v, want at least 100ms", got[1].Waited)
+	}
+
+	var b bytes.Buffer
+	if err := collector.WriteJSON(&b); err != nil {
+		t.Fatalf("WriteJSON() unexpected error: %v", err)
+	}
+	var decoded []map[string]any
+	if err := json.Unmarshal(b.Bytes(), &decoded); err != nil {
+		t.Fatalf("WriteJSON() wrote invalid JSON: %v\n%s", err, b.String())
+	}
+	if len(decoded) != len(want) {
+		t.Errorf("WriteJSON() wrote %d results, want %d", len(decoded), len(want))
+	}
+	if !strings.Contains(b.String(), `"waited_ns"`) {
+		t.Errorf("WriteJSON() missing waited_ns:\n%s", b.String())
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openconfig/ygnmi/ygnmi"
+	"github.com/openconfig/ygot/ygot"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// wildcardValidation is the common implementation of Validator for wildcard
+// queries. The validation function is run on the value of every matching
+// element.
+type wildcardValidation[T any] struct {
+	query        ygnmi.WildcardQuery[T]
+	validationFn func(*ygnmi.Value[T]) error
+	// want describes the expected value, if known, for validation reports.
+	want string
+}
+
+var _ Validator = (*wildcardValidation[any])(nil)
+
+// Path returns a string representation of the path being validated.
+func (vd *wildcardValidation[T]) Path() string {
+	return FormatPath(vd.query.PathStruct())
+}
+
+// RelPath returns a string representation of the path being validated,
+// relative to some base.
+func (vd *wildcardValidation[T]) RelPath(base ygnmi.PathStruct) string {
+	return FormatRelativePath(base, vd.query.PathStruct())
+}
+
+// wildcardBase returns the part of the path before the first wildcard, which
+// element paths are formatted relative to.
+func wildcardBase(path ygnmi.PathStruct) string {
+	gpath, _, err := ygnmi.ResolvePath(path)
+	if err != nil {
+		return ""
+	}
+	base := &gpb.Path{}
+	for _, e := range gpath.GetElem() {
+		if e.GetName() == "*" || e.GetName() == "..." {
+			break
+		}
+		wild := false
+		for _, v := range e.GetKey() {
+			if v == "*" {
+				wild = true
+			}
+		}
+		if wild {
+			break
+		}
+		base.Elem = append(base.Elem, e)
+	}
+	str, err := ygot.PathToString(base)
+	if err != nil {
+		return ""
+	}
+	return str
+}
+
+// pathKey returns a string identifying an element path.
+func pathKey(path *gpb.Path) string {
+	str, err := ygot.PathToString(path)
+	if err != nil {
+		return path.String()
+	}
+	return str
+}
+
+// elemPath formats the path of a matching element relative to the wildcard.
+func (vd *wildcardValidation[T]) elemPath(v *ygnmi.Value[T]) string {
+	str, err := ygot.PathToString(v.Path)
+	if err != nil {
+		return fmt.Sprintf("<Unprintable path: %v>", err)
+	}
+	return formatRelative(wildcardBase(vd.query.PathStruct()), str)
+}
+
+// validate runs the validation function on every element, and returns an error
+// naming each element that failed. There must be at least one element.
+func (vd *wildcardValidation[T]) validate(vals []*ygnmi.Value[T]) error {
+	if len(vals) == 0 {
+		return errors.New("got no matching elements, want at least one")
+	}
+	var failed []string
+	for _, v := range vals {
+		if err := vd.validationFn(v); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", vd.elemPath(v), err))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	sort.Strings(failed)
+	return fmt.Errorf("%d of %d elements failed: %s", len(failed), len(vals), strings.Join(failed, "; "))
+}
+
+// Check tests the validation condition on every element immediately and
+// returns an error if it fails on any of them.
+func (vd *wildcardValidation[T]) Check(client *ygnmi.Client) error {
+	_, err := vd.check(client, nil)
+	return err
+}
+
+// check is Check that also returns the values of the elements, and records them
+// in obs.
+func (vd *wildcardValidation[T]) check(client *ygnmi.Client, obs *observation) ([]*ygnmi.Value[T], error) {
+	vals, err := ygnmi.LookupAll(context.Background(), client, vd.query)
+	if err != nil {
+		return nil, &validationError[T]{
+			query:        vd.query,
+			failureCause: err,
+		}
+	}
+	vd.observe(obs, vals)
+	if err := vd.validate(vals); err != nil {
+		return vals, &validationError[T]{
+			query:         vd.query,
+			validationErr: err,
+		}
+	}
+	return vals, nil
+}
+
+// observe records the values of the elements in obs.
+func (vd *wildcardValidation[T]) observe(obs *observation, vals []*ygnmi.Value[T]) {
+	if obs == nil {
+		return
+	}
+	got := make([]string, len(vals))
+	var latest time.Time
+	for i, v := range vals {
+		got[i] = fmt.Sprintf("%s: %s", vd.elemPath(v), FormatValue(v))
+		if v.Timestamp.After(latest) {
+			latest = v.Timestamp
+		}
+	}
+	obs.observe(strings.Join(got, "; "), latest)
+}
+
+// present returns the present values in a map of values keyed by path, sorted
+// by path.
+func present[T any](m map[string]*ygnmi.Value[T]) []*ygnmi.Value[T] {
+	var keys []string
+	for k, v := range m {
+		if v.IsPresent() {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	vals := make([]*ygnmi.Value[T], len(keys))
+	for i, k := range keys {
+		vals[i] = m[k]
+	}
+	return vals
+}
+
+// Await waits for the validation condition to pass on every element. Elements
+// that are deleted while waiting are no longer validated.
+func (vd *wildcardValidation[T]) Await(ctx context.Context, client *ygnmi.Client) error {
+	return vd.await(ctx, client, nil)
+}
+
+// await is Await that records the last values it received in obs.
+func (vd *wildcardValidation[T]) await(ctx context.Context, client *ygnmi.Client, obs *observation) error {
+	var checkErr *validationError[T]
+	initial, err := vd.check(client, obs)
+	if err == nil || !errors.As(err, &checkErr) || checkErr.failureCause != nil {
+		return err
+	}
+	lastInvalid := checkErr.validationErr
+	// Start from the checked elements, since the watch updates them one at a time.
+	vals := map[string]*ygnmi.Value[T]{}
+	for _, v := range initial {
+		vals[pathKey(v.Path)] = v
+	}
+	watcher := ygnmi.WatchAll(ctx, client, vd.query, func(v *ygnmi.Value[T]) error {
+		vals[pathKey(v.Path)] = v
+		vd.observe(obs, present(vals))
+		if lastInvalid = vd.validate(present(vals)); lastInvalid != nil {
+			return ygnmi.Continue
+		}
+		return nil
+	})
+	_, err = watcher.Await()
+	if err != nil {
+		failed := &validationError[T]{
+			query:        vd.query,
+			failureCause: err,
+		}
+		if lastInvalid != nil {
+			failed.validationErr = lastInvalid
+		}
+		return failed
+	}
+	return nil
+}
+
+// AwaitFor calls Await with a context with deadline now + timeout. If timeout
+// is <= 0, this is equivalent to Check().
+func (vd *wildcardValidation[T]) AwaitFor(timeout time.Duration, client *ygnmi.Client) error {
+	return awaitFor(vd, timeout, client)
+}
+
+// AwaitUntil calls Await with a context with the given deadline. If deadline
+// is in the past, this is equivalent to Check().
+func (vd *wildcardValidation[T]) AwaitUntil(deadline time.Time, client *ygnmi.Client) error {
+	return awaitUntil(vd, deadline, client)
+}
+
+// hold watches every element until the context ends, and fails if any value
+// changes or stops passing validation.
+func (vd *wildcardValidation[T]) hold(ctx context.Context, client *ygnmi.Client) error {
+	start := time.Now()
+	first := map[string]*ygnmi.Value[T]{}
+	var holdErr error
+	watcher := ygnmi.WatchAll(ctx, client, vd.query, func(v *ygnmi.Value[T]) error {
+		key := pathKey(v.Path)
+		f := first[key]
+		if err := holdValue(&f, v, vd.validationFn, start); err != nil {
+			holdErr = fmt.Errorf("%s: %w", vd.elemPath(v), err)
+			return holdErr
+		}
+		first[key] = f
+		return ygnmi.Continue
+	})
+	_, err := watcher.Await()
+	return endHold(vd.query, err, holdErr)
+}
+
+// ValidateAll expects validationFn to return no error on the value of every
+// element matching the wildcard query. It fails if no elements match.
+func ValidateAll[T any, QT ygnmi.WildcardQuery[T]](query QT, validationFn func(*ygnmi.Value[T]) error) Validator {
+	return &wildcardValidation[T]{query: query, validationFn: validationFn}
+}
+
+// PredicateAll expects that every element matching the wildcard query has a
+// value and the given predicate returns true on it. Errors name the elements
+// that failed relative to the wildcard, e.g.
+//
+//	"/components/component[name=*]/state/oper-status: 1 of 4 elements failed: component[name=PSU1]/state/oper-status: got INACTIVE, want ACTIVE".
+func PredicateAll[T any, QT ygnmi.WildcardQuery[T]](query QT, wantMsg string, predicate func(T) bool) Validator {
+	return &wildcardValidation[T]{
+		query: query,
+		validationFn: func(vgot *ygnmi.Value[T]) error {
+			got, present := vgot.Val()
+			if !present || !predicate(got) {
+				return fmt.Errorf("got %s, %s", FormatValue(vgot), wantMsg)
+			}
+			return nil
+		},
+		want: wantMsg,
+	}
+}
+
+// EqualAll expects the value of every element matching the wildcard query to be
+// want.
+func EqualAll[T any, QT ygnmi.WildcardQuery[T]](query QT, want T) Validator {
+	return PredicateAll(query, fmt.Sprintf("want %#v", want), func(got T) bool {
+		return reflect.DeepEqual(got, want)
+	})
+}
+
+// NotEqualAll expects every element matching the wildcard query to have a value
+// other than wantNot.
+func NotEqualAll[T any, QT ygnmi.WildcardQuery[T]](query QT, wantNot T) Validator {
+	return PredicateAll(query, fmt.Sprintf("want anything but %#v", wantNot), func(got T) bool {
+		return !reflect.DeepEqual(got, wantNot)
+	})
+}
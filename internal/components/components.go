@@ -17,6 +17,7 @@ package components
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"testing"
@@ -187,6 +188,55 @@ func (y Y) FindByType(ctx context.Context, want oc.Component_Type_Union) ([]stri
 	return names, nil
 }
 
+// Inventory returns the components of the device with only their type and their
+// inventory leaves set: the description, part and serial numbers, manufacturer and
+// versions, and the vendor details of transceivers.  It looks up each leaf across all
+// the components, rather than whole components, which can be large.  The leaves
+// that could not be looked up are left unset and their errors are returned along
+// with the components.
+func (y Y) Inventory(ctx context.Context) (map[string]*oc.Component, error) {
+	comps := make(map[string]*oc.Component)
+	c := ocpath.Root().ComponentAny()
+	t := c.Transceiver()
+	errs := []error{
+		lookupLeaf(ctx, y.Client, comps, c.Type().State(), func(c *oc.Component, v oc.Component_Type_Union) { c.Type = v }),
+		lookupLeaf(ctx, y.Client, comps, c.Description().State(), func(c *oc.Component, v string) { c.Description = &v }),
+		lookupLeaf(ctx, y.Client, comps, c.PartNo().State(), func(c *oc.Component, v string) { c.PartNo = &v }),
+		lookupLeaf(ctx, y.Client, comps, c.SerialNo().State(), func(c *oc.Component, v string) { c.SerialNo = &v }),
+		lookupLeaf(ctx, y.Client, comps, c.MfgName().State(), func(c *oc.Component, v string) { c.MfgName = &v }),
+		lookupLeaf(ctx, y.Client, comps, c.HardwareVersion().State(), func(c *oc.Component, v string) { c.HardwareVersion = &v }),
+		lookupLeaf(ctx, y.Client, comps, c.FirmwareVersion().State(), func(c *oc.Component, v string) { c.FirmwareVersion = &v }),
+		lookupLeaf(ctx, y.Client, comps, c.SoftwareVersion().State(), func(c *oc.Component, v string) { c.SoftwareVersion = &v }),
+		lookupLeaf(ctx, y.Client, comps, t.Vendor().State(), func(c *oc.Component, v string) { c.GetOrCreateTransceiver().Vendor = &v }),
+		lookupLeaf(ctx, y.Client, comps, t.VendorPart().State(), func(c *oc.Component, v string) { c.GetOrCreateTransceiver().VendorPart = &v }),
+		lookupLeaf(ctx, y.Client, comps, t.VendorRev().State(), func(c *oc.Component, v string) { c.GetOrCreateTransceiver().VendorRev = &v }),
+	}
+	return comps, errors.Join(errs...)
+}
+
+// lookupLeaf looks up a leaf across all the components, and sets it in the components
+// keyed by name.
+func lookupLeaf[T any](ctx context.Context, c *ygnmi.Client, comps map[string]*oc.Component, q ygnmi.WildcardQuery[T], set func(*oc.Component, T)) error {
+	values, err := ygnmi.LookupAll(ctx, c, q)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		v, ok := value.Val()
+		if !ok {
+			continue
+		}
+		name := value.Path.GetElem()[1].GetKey()["name"]
+		comp, ok := comps[name]
+		if !ok {
+			comp = &oc.Component{Name: &name}
+			comps[name] = comp
+		}
+		set(comp, v)
+	}
+	return nil
+}
+
 // FindStandbyControllerCard gets a list of two components and finds out the active and standby controller_cards.
 func FindStandbyControllerCard(t *testing.T, dut *ondatra.DUTDevice, supervisors []string) (string, string) {
 	var activeCC, standbyCC string
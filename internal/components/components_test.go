@@ -15,10 +15,16 @@
 package components
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/openconfig/featureprofiles/topologies/binding/fakedut"
+	"github.com/openconfig/ondatra"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/gnmi/oc"
 )
 
 func TestFindMatchingStrings(t *testing.T) {
@@ -41,3 +47,44 @@ func TestFindMatchingStrings(t *testing.T) {
 		t.Errorf("FindMatchingStrings(%s) returned unexpected diff (-want +got):\n%s", args, diff)
 	}
 }
+
+func TestFindComponentsByTypeFakeDUT(t *testing.T) {
+	fake := fakedut.New(&binding.Dims{Name: "fakedut1"})
+	if err := fake.Update(func(root *oc.Root) {
+		for name, status := range map[string]oc.E_PlatformTypes_COMPONENT_OPER_STATUS{
+			"Linecard0": oc.PlatformTypes_COMPONENT_OPER_STATUS_ACTIVE,
+			"Linecard1": oc.PlatformTypes_COMPONENT_OPER_STATUS_INACTIVE,
+		} {
+			c := root.GetOrCreateComponent(name)
+			c.Type = oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_LINECARD
+			c.OperStatus = status
+		}
+		c := root.GetOrCreateComponent("Chassis")
+		c.Type = oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_CHASSIS
+		c = root.GetOrCreateComponent("OS")
+		c.Type = oc.PlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT_OPERATING_SYSTEM
+	}); err != nil {
+		t.Fatalf("Could not set up the DUT: %v", err)
+	}
+	fakedut.Setup(t, map[string]*fakedut.DUT{"dut": fake})
+	dut := ondatra.DUT(t, "dut")
+
+	sorted := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if diff := cmp.Diff([]string{"Linecard0", "Linecard1"}, FindComponentsByType(t, dut, oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_LINECARD), sorted); diff != "" {
+		t.Errorf("FindComponentsByType() returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"Linecard0"}, FindActiveComponentsByType(t, dut, oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_LINECARD)); diff != "" {
+		t.Errorf("FindActiveComponentsByType() returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"OS"}, FindSWComponentsByType(t, dut, oc.PlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT_OPERATING_SYSTEM)); diff != "" {
+		t.Errorf("FindSWComponentsByType() returned unexpected diff (-want +got):\n%s", diff)
+	}
+
+	got, err := New(t, dut).FindByType(context.Background(), oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_CHASSIS)
+	if err != nil {
+		t.Fatalf("FindByType() got error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"Chassis"}, got); diff != "" {
+		t.Errorf("FindByType() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
@@ -14,23 +14,22 @@
 
 // Package core provides a validator for being able to
 // check for core files on DUT's before and after test
-// modules runs.
+// modules runs.  The core file check is one of the pluggable
+// health checks, along with others such as process restarts
+// and alarms.
 package core
 
 import (
-	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"sync"
-	"text/template"
-	"time"
 
 	"github.com/golang/glog"
 	"github.com/openconfig/ondatra"
 	"github.com/openconfig/ondatra/binding"
-	"github.com/openconfig/ondatra/eventlis"
+	"github.com/openconfig/ygnmi/ygnmi"
 
 	fpb "github.com/openconfig/gnoi/file"
 	opb "github.com/openconfig/ondatra/proto"
@@ -51,20 +50,30 @@ var (
 	}
 )
 
-var (
-	validator validatorImpl
-)
-
 type fileInfo struct {
 	Name     string
 	Path     string
 	Modified uint64
+	Size     uint64
+	// Local is the path of the downloaded core file, relative to the test output
+	// directory.
+	Local string
+	// SHA256 is the hex encoded checksum of the downloaded core file.
+	SHA256 string
+	// DownloadErr describes why the core file could not be downloaded.
+	DownloadErr string
 }
 
-type dutCoreFiles struct {
-	DUT    string
-	Files  coreFiles
-	Status string
+// String describes the core file and its download.
+func (fi fileInfo) String() string {
+	s := fi.Name
+	if fi.Local != "" {
+		s += fmt.Sprintf(" downloaded to %s sha256:%s", fi.Local, fi.SHA256)
+	}
+	if fi.DownloadErr != "" {
+		s += " download failed: " + fi.DownloadErr
+	}
+	return s
 }
 
 type coreFiles map[string]fileInfo
@@ -72,10 +81,6 @@ type coreFiles map[string]fileInfo
 type checker struct {
 	dut        binding.DUT
 	fileClient fpb.FileClient
-
-	mu        sync.Mutex
-	startTime time.Time
-	prevCores coreFiles
 }
 
 func newChecker(dut binding.DUT) (*checker, error) {
@@ -94,149 +99,98 @@ func newChecker(dut binding.DUT) (*checker, error) {
 	return &checker{
 		dut:        dut,
 		fileClient: gClients.File(),
-		prevCores:  coreFiles{},
-		startTime:  time.Now(),
 	}, nil
 }
 
-func (c *checker) check() (coreFiles, error) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	cores, err := c.checkCores()
+var (
+	checkersMu sync.Mutex
+	// checkers caches the core file checker of each DUT by name.
+	checkers = map[string]*checker{}
+)
+
+// checkerFor returns the core file checker of a DUT, dialing gNOI the first time.
+func checkerFor(dut binding.DUT) (*checker, error) {
+	checkersMu.Lock()
+	defer checkersMu.Unlock()
+	if c, ok := checkers[dut.Name()]; ok {
+		return c, nil
+	}
+	c, err := newChecker(dut)
 	if err != nil {
 		return nil, err
 	}
-	delta := coreFiles{}
-	for k, v := range cores {
-		if _, ok := c.prevCores[k]; !ok {
-			delta[k] = v
-		}
-	}
-	c.prevCores = cores
-	return delta, nil
+	checkers[dut.Name()] = c
+	return c, nil
 }
 
-type validatorImpl struct {
-	mu   sync.Mutex
-	duts map[string]*checker
+// coreSnapshot holds the core files on a DUT, with the checker that found them.
+type coreSnapshot struct {
+	c     *checker
+	files coreFiles
 }
 
-func (v *validatorImpl) check() map[string]dutCoreFiles {
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	dutCores := map[string]dutCoreFiles{}
-	for _, c := range v.duts {
-		wg.Add(1)
-		go func(c *checker) {
-			defer wg.Done()
-			cores, err := c.check()
-			status := "OK"
-			if err != nil {
-				status = fmt.Sprintf("DUT %q failed to check cores: %v", c.dut.Name(), err)
-				glog.Warning(status)
-			}
-			mu.Lock()
-			defer mu.Unlock()
-			dutCores[c.dut.Name()] = dutCoreFiles{
-				DUT:    c.dut.Name(),
-				Files:  cores,
-				Status: status,
-			}
-		}(c)
+// coreCheck reports new core files, and downloads them into the test output directory
+// if -core_download is set.
+type coreCheck struct{}
+
+func (coreCheck) Name() string { return "cores" }
+
+func (coreCheck) Snapshot(ctx context.Context, dut binding.DUT, _ *ygnmi.Client) (any, error) {
+	c, err := checkerFor(dut)
+	if err != nil {
+		return nil, err
 	}
-	wg.Wait()
-	return dutCores
+	files, err := c.checkCores(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &coreSnapshot{c: c, files: files}, nil
 }
 
-// start starts a core file watcher for the provided DUT.
-func (v *validatorImpl) start(duts map[string]binding.DUT) map[string]dutCoreFiles {
-	v.mu.Lock()
-	defer v.mu.Unlock()
-	for k, dut := range duts {
-		glog.Infof("Registering core file checking for DUT %q", k)
-		c, err := newChecker(dut)
-		if err != nil {
-			glog.Warningf("Failed to register core file checking for DUT %q: %v", k, err)
-			continue
+func (coreCheck) Delta(before, after any) []string {
+	b, a := before.(*coreSnapshot), after.(*coreSnapshot)
+	files := coreFiles{}
+	for k, v := range a.files {
+		if _, ok := b.files[k]; !ok {
+			files[k] = v
 		}
-		v.duts[k] = c
 	}
-	return v.check()
-}
-
-// Stop ends the validator and returns a list of all DUTs that
-// found core files.
-func (v *validatorImpl) stop() map[string]dutCoreFiles {
-	v.mu.Lock()
-	defer v.mu.Unlock()
-	return v.check()
-}
-
-func registerBefore(e *eventlis.BeforeTestsEvent) error {
-	cores := validator.start(e.Reservation.DUTs)
-	ondatra.Report().AddSuiteProperty("validator.core", "enabled")
-	report := createReport(cores)
-	ondatra.Report().AddSuiteProperty("validator.core.initial", report)
-	return nil
-}
-
-const (
-	coreFmt = `
-Delta Core Files by DUT:{{range $key, $dut := .}} 
-DUT: {{$key}}{{ range $key, $cores := $dut.Files }}
-  {{ $key }}{{ end }}{{ end }}`
-)
-
-var coreTemplate = template.Must(template.New("errorMsg").Parse(coreFmt))
-
-func createReport(d map[string]dutCoreFiles) string {
-	b := new(bytes.Buffer)
-	if err := coreTemplate.Execute(b, d); err != nil {
-		b.Reset()
-		fmt.Fprintf(b, "parse error on retrieving core files: %v", err)
+	if len(files) == 0 {
+		return nil
 	}
-	return b.String()
-}
-
-func registerAfter(_ *eventlis.AfterTestsEvent) error {
-	cores := validator.stop()
-	foundCores := false
-	for _, files := range cores {
-		if len(files.Files) > 0 {
-			foundCores = true
-			break
+	if *coreDownload {
+		if dir := OutputsDir(); dir != "" {
+			a.c.downloadAll(dir, files)
+		} else {
+			glog.Warning("Core files are not downloaded without -outputs_dir.  Please specify -outputs_dir to keep them.")
 		}
 	}
-	report := createReport(cores)
-	msg := fmt.Sprintf("core file check found cores:\n%s", report)
-	glog.Infof(msg)
-	ondatra.Report().AddSuiteProperty("validator.core.end", report)
-	if foundCores {
-		return errors.New(msg)
+	var deltas []string
+	for _, fi := range files {
+		deltas = append(deltas, fi.String())
 	}
-	return nil
+	sort.Strings(deltas)
+	return deltas
 }
 
-// Register will register core file watcher with the caller.
+// Register will register the health checks enabled by -health_checks, by default
+// the core file check, with the caller.
 // This will allow the event listener to fire on test module start and end.
 // All DUTs in the reservation will be monitored.
 func Register() {
-	validator = validatorImpl{
-		duts: map[string]*checker{},
-	}
-	ondatra.EventListener().AddBeforeTestsCallback(registerBefore)
-	ondatra.EventListener().AddAfterTestsCallback(registerAfter)
+	ondatra.EventListener().AddBeforeTestsCallback(healthBefore)
+	ondatra.EventListener().AddAfterTestsCallback(healthAfter)
 }
 
 // coreFileCheck function is used to check if cores are found on the DUT.
-func (c *checker) checkCores() (coreFiles, error) {
+func (c *checker) checkCores(ctx context.Context) (coreFiles, error) {
 	dutVendor := c.dut.Vendor()
 	corePath := vendorCoreFilePath[dutVendor]
 	fileMatch := vendorCoreFileNamePattern[dutVendor]
 	in := &fpb.StatRequest{
 		Path: corePath,
 	}
-	validResponse, err := c.fileClient.Stat(context.Background(), in)
+	validResponse, err := c.fileClient.Stat(ctx, in)
 	if err != nil {
 		return nil, fmt.Errorf("DUT %q: %w", corePath, err)
 	}
@@ -247,7 +201,7 @@ func (c *checker) checkCores() (coreFiles, error) {
 		in = &fpb.StatRequest{
 			Path: fileStatsInfo.GetPath(),
 		}
-		validResponse, err := c.fileClient.Stat(context.Background(), in)
+		validResponse, err := c.fileClient.Stat(ctx, in)
 		if err != nil {
 			return nil, fmt.Errorf("DUT %q: unable to stat file %q, %v", c.dut.Name(), fileStatsInfo.GetPath(), err)
 		}
@@ -257,6 +211,7 @@ func (c *checker) checkCores() (coreFiles, error) {
 				cores[coreFileName] = fileInfo{
 					Name:     coreFileName,
 					Modified: fileStatsInfo.GetLastModified(),
+					Size:     filesMatched.GetSize(),
 				}
 			}
 		}
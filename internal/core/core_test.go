@@ -27,6 +27,7 @@ import (
 	"github.com/openconfig/ondatra/fakebind"
 	"google.golang.org/grpc"
 
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
 	fpb "github.com/openconfig/gnoi/file"
 	opb "github.com/openconfig/ondatra/proto"
 )
@@ -60,415 +61,183 @@ func (f *fakeFileClient) Stat(_ context.Context, _ *fpb.StatRequest, _ ...grpc.C
 	return nil, fmt.Errorf("invalid response type: %T", resp)
 }
 
-func TestCoreValidator(t *testing.T) {
+// fakeDUT returns an Arista DUT whose gNOI File service answers Stat with the given
+// responses.
+func fakeDUT(name string, stats ...any) *fakebind.DUT {
+	return &fakebind.DUT{
+		AbstractDUT: &binding.AbstractDUT{
+			Dims: &binding.Dims{
+				Vendor: opb.Device_ARISTA,
+				Name:   name,
+			},
+		},
+		DialGNMIFn: func(context.Context, ...grpc.DialOption) (gpb.GNMIClient, error) {
+			return nil, fmt.Errorf("gnmi dial failed")
+		},
+		DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
+			return &fakeGNOI{
+				fakeFileClient: &fakeFileClient{
+					statResponses: stats,
+				},
+			}, nil
+		},
+	}
+}
+
+func TestCoreCheck(t *testing.T) {
 	tests := []struct {
 		desc       string
-		duts       map[string]binding.DUT
+		dut        binding.DUT
 		startErr   string
-		stopErr    string
-		cores      map[string]dutCoreFiles
-		startCores map[string]dutCoreFiles
+		startCores coreFiles
+		delta      []string
 	}{{
 		desc: "invalid dut vendor",
-		duts: map[string]binding.DUT{
-			"dut1": &fakebind.DUT{
-				AbstractDUT: &binding.AbstractDUT{
-					Dims: &binding.Dims{
-						Vendor: opb.Device_VENDOR_UNSPECIFIED,
-						Name:   "dut1",
-					},
+		dut: &fakebind.DUT{
+			AbstractDUT: &binding.AbstractDUT{
+				Dims: &binding.Dims{
+					Vendor: opb.Device_VENDOR_UNSPECIFIED,
+					Name:   "dut1",
 				},
 			},
 		},
-		startCores: map[string]dutCoreFiles{},
-		cores:      map[string]dutCoreFiles{},
+		startErr: "add support for vendor VENDOR_UNSPECIFIED",
 	}, {
 		desc: "dut gnoi error",
-		duts: map[string]binding.DUT{
-			"dut1": &fakebind.DUT{
-				AbstractDUT: &binding.AbstractDUT{
-					Dims: &binding.Dims{
-						Vendor: opb.Device_ARISTA,
-						Name:   "dut1",
-					},
-				},
-				DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
-					return nil, fmt.Errorf("gnoi dial failed")
-				},
-			},
-		},
-		startCores: map[string]dutCoreFiles{},
-		cores:      map[string]dutCoreFiles{},
-	}, {
-		desc: "dut gnoi rpc match stat fail",
-		duts: map[string]binding.DUT{
-			"dut1": &fakebind.DUT{
-				AbstractDUT: &binding.AbstractDUT{
-					Dims: &binding.Dims{
-						Vendor: opb.Device_ARISTA,
-						Name:   "dut1",
-					},
-				},
-				DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
-					return &fakeGNOI{
-						fakeFileClient: &fakeFileClient{
-							statResponses: []any{
-								fmt.Errorf("gnoi.File.Stat failed"),
-								fmt.Errorf("gnoi.File.Stat failed"),
-								fmt.Errorf("gnoi.File.Stat failed"),
-								fmt.Errorf("gnoi.File.Stat failed"),
-							},
-						},
-					}, nil
+		dut: &fakebind.DUT{
+			AbstractDUT: &binding.AbstractDUT{
+				Dims: &binding.Dims{
+					Vendor: opb.Device_ARISTA,
+					Name:   "dut1",
 				},
 			},
-		},
-		startCores: map[string]dutCoreFiles{
-			"dut1": {
-				DUT:    "dut1",
-				Status: `DUT "dut1" failed to check cores: DUT "/var/core/": gnoi.File.Stat failed`,
-			},
-		},
-		cores: map[string]dutCoreFiles{
-			"dut1": {
-				DUT:    "dut1",
-				Status: `DUT "dut1" failed to check cores: DUT "/var/core/": gnoi.File.Stat failed`,
+			DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
+				return nil, fmt.Errorf("gnoi dial failed")
 			},
 		},
+		startErr: "gnoi dial failed",
+	}, {
+		desc:     "dut gnoi rpc match stat fail",
+		dut:      fakeDUT("dut1", fmt.Errorf("gnoi.File.Stat failed")),
+		startErr: `DUT "/var/core/": gnoi.File.Stat failed`,
 	}, {
 		desc: "dut gnoi rpc file stat failed",
-		duts: map[string]binding.DUT{
-			"dut1": &fakebind.DUT{
-				AbstractDUT: &binding.AbstractDUT{
-					Dims: &binding.Dims{
-						Vendor: opb.Device_ARISTA,
-						Name:   "dut1",
-					},
-				},
-				DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
-					return &fakeGNOI{
-						fakeFileClient: &fakeFileClient{
-							statResponses: []any{
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.1.tar.gz",
-									}},
-								},
-								fmt.Errorf("gnoi.File.Stat failed"),
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.1.tar.gz",
-									}},
-								},
-								fmt.Errorf("gnoi.File.Stat failed"),
-							},
-						},
-					}, nil
-				},
-			},
-		},
-		startCores: map[string]dutCoreFiles{
-			"dut1": {
-				DUT:    "dut1",
-				Status: `DUT "dut1" failed to check cores: DUT "dut1": unable to stat file "/var/core/core.1.tar.gz", gnoi.File.Stat failed`,
-			},
-		},
-		cores: map[string]dutCoreFiles{
-			"dut1": {
-				DUT:    "dut1",
-				Status: `DUT "dut1" failed to check cores: DUT "dut1": unable to stat file "/var/core/core.1.tar.gz", gnoi.File.Stat failed`,
-			},
-		},
+		dut: fakeDUT("dut1",
+			statResponse("/var/core/core.1.tar.gz"),
+			fmt.Errorf("gnoi.File.Stat failed"),
+		),
+		startErr: `DUT "dut1": unable to stat file "/var/core/core.1.tar.gz", gnoi.File.Stat failed`,
 	}, {
 		desc: "dut gnoi pass no delta",
-		duts: map[string]binding.DUT{
-			"dut1": &fakebind.DUT{
-				AbstractDUT: &binding.AbstractDUT{
-					Dims: &binding.Dims{
-						Vendor: opb.Device_ARISTA,
-						Name:   "dut1",
-					},
-				},
-				DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
-					return &fakeGNOI{
-						fakeFileClient: &fakeFileClient{
-							statResponses: []any{
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.1.tar.gz",
-									}},
-								},
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.1.tar.gz",
-									}},
-								},
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.1.tar.gz",
-									}},
-								},
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.1.tar.gz",
-									}},
-								},
-							},
-						},
-					}, nil
-				},
-			},
-		},
-		startCores: map[string]dutCoreFiles{
-			"dut1": {
-				DUT: "dut1",
-				Files: coreFiles{
-					"/var/core/core.1.tar.gz": fileInfo{
-						Name: "/var/core/core.1.tar.gz",
-					},
-				},
-				Status: "OK",
-			},
-		},
-		cores: map[string]dutCoreFiles{
-			"dut1": {
-				DUT:    "dut1",
-				Files:  coreFiles{},
-				Status: "OK",
+		dut: fakeDUT("dut1",
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+		),
+		startCores: coreFiles{
+			"/var/core/core.1.tar.gz": fileInfo{
+				Name: "/var/core/core.1.tar.gz",
 			},
 		},
 	}, {
 		desc: "dut gnoi pass delta",
-		duts: map[string]binding.DUT{
-			"dut1": &fakebind.DUT{
-				AbstractDUT: &binding.AbstractDUT{
-					Dims: &binding.Dims{
-						Vendor: opb.Device_ARISTA,
-						Name:   "dut1",
-					},
-				},
-				DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
-					return &fakeGNOI{
-						fakeFileClient: &fakeFileClient{
-							statResponses: []any{
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.1.tar.gz",
-									}},
-								},
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.1.tar.gz",
-									}},
-								},
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.1.tar.gz",
-									}, {
-										Path: "/var/core/core.2.tar.gz",
-									}},
-								},
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.1.tar.gz",
-									}},
-								},
-								&fpb.StatResponse{
-									Stats: []*fpb.StatInfo{{
-										Path: "/var/core/core.2.tar.gz",
-									}},
-								},
-							},
-						},
-					}, nil
-				},
-			},
-		},
-		cores: map[string]dutCoreFiles{
-			"dut1": {
-				DUT: "dut1",
-				Files: coreFiles{
-					"/var/core/core.2.tar.gz": fileInfo{
-						Name: "/var/core/core.2.tar.gz",
-					},
-				},
-				Status: "OK",
-			},
-		},
-		startCores: map[string]dutCoreFiles{
-			"dut1": {
-				DUT: "dut1",
-				Files: coreFiles{
-					"/var/core/core.1.tar.gz": fileInfo{
-						Name: "/var/core/core.1.tar.gz",
-					},
-				},
-				Status: "OK",
+		dut: fakeDUT("dut1",
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz", "/var/core/core.2.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.2.tar.gz"),
+		),
+		startCores: coreFiles{
+			"/var/core/core.1.tar.gz": fileInfo{
+				Name: "/var/core/core.1.tar.gz",
 			},
 		},
+		delta: []string{"/var/core/core.2.tar.gz"},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			validator = validatorImpl{
-				duts: map[string]*checker{},
+			checkers = map[string]*checker{}
+			before, err := coreCheck{}.Snapshot(context.Background(), tt.dut, nil)
+			if s := errdiff.Substring(err, tt.startErr); s != "" {
+				t.Fatalf("Snapshot() before: %s", s)
+			}
+			if err != nil {
+				return
 			}
-			cores := validator.start(tt.duts)
-			if s := cmp.Diff(cores, tt.startCores); s != "" {
-				t.Fatalf("Start(%+v) core check failed: %s", tt.duts, s)
+			if s := cmp.Diff(tt.startCores, before.(*coreSnapshot).files); s != "" {
+				t.Fatalf("Snapshot() before unexpected diff (-want,+got):\n%s", s)
 			}
-			cores = validator.stop()
-			if s := cmp.Diff(cores, tt.cores); s != "" {
-				t.Fatalf("Stop() core check failed: %s", s)
+			after, err := coreCheck{}.Snapshot(context.Background(), tt.dut, nil)
+			if err != nil {
+				t.Fatalf("Snapshot() after got error: %v", err)
+			}
+			if s := cmp.Diff(tt.delta, coreCheck{}.Delta(before, after)); s != "" {
+				t.Errorf("Delta() unexpected diff (-want,+got):\n%s", s)
 			}
 		})
 	}
 }
 
 func TestEventCallback(t *testing.T) {
+	defer func(checks, failOn string) {
+		*healthChecksFlag, *healthFailOnChange = checks, failOn
+	}(*healthChecksFlag, *healthFailOnChange)
+
 	tests := []struct {
-		desc      string
-		dut       *fakebind.DUT
-		beforeErr string
-		afterErr  string
+		desc     string
+		dut      *fakebind.DUT
+		failOn   string
+		afterErr string
 	}{{
-		desc: "Fail to register (this will only log error)",
-		dut: &fakebind.DUT{
-			AbstractDUT: &binding.AbstractDUT{
-				Dims: &binding.Dims{
-					Vendor: opb.Device_ARISTA,
-				},
-			},
-			DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
-				return &fakeGNOI{
-					fakeFileClient: &fakeFileClient{
-						statResponses: []any{
-							fmt.Errorf("gnoi.File.Stat failed"),
-						},
-					},
-				}, nil
-			},
-		},
+		desc:   "Fail to register (this will only log error)",
+		dut:    fakeDUT("", fmt.Errorf("gnoi.File.Stat failed")),
+		failOn: "cores",
 	}, {
 		desc: "Fail on stop (this will also be ignored)",
-		dut: &fakebind.DUT{
-			AbstractDUT: &binding.AbstractDUT{
-				Dims: &binding.Dims{
-					Vendor: opb.Device_ARISTA,
-					Name:   "dut1",
-				},
-			},
-			DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
-				return &fakeGNOI{
-					fakeFileClient: &fakeFileClient{
-						statResponses: []any{
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.1.tar.gz",
-								}},
-							},
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.1.tar.gz",
-								}},
-							},
-							fmt.Errorf("gnoi.File.Stat failed"),
-						},
-					},
-				}, nil
-			},
-		},
+		dut: fakeDUT("dut1",
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			fmt.Errorf("gnoi.File.Stat failed"),
+		),
+		failOn: "cores",
 	}, {
 		desc: "After returns no new core",
-		dut: &fakebind.DUT{
-			AbstractDUT: &binding.AbstractDUT{
-				Dims: &binding.Dims{
-					Vendor: opb.Device_ARISTA,
-					Name:   "dut1",
-				},
-			},
-			DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
-				return &fakeGNOI{
-					fakeFileClient: &fakeFileClient{
-						statResponses: []any{
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.1.tar.gz",
-								}},
-							},
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.1.tar.gz",
-								}},
-							},
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.1.tar.gz",
-								}},
-							},
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.1.tar.gz",
-								}},
-							},
-						},
-					},
-				}, nil
-			},
-		},
+		dut: fakeDUT("dut1",
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+		),
+		failOn: "cores",
 	}, {
 		desc: "After returns error for core found",
-		dut: &fakebind.DUT{
-			AbstractDUT: &binding.AbstractDUT{
-				Dims: &binding.Dims{
-					Vendor: opb.Device_ARISTA,
-					Name:   "dut1",
-				},
-			},
-			DialGNOIFn: func(_ context.Context, _ ...grpc.DialOption) (gnoigo.Clients, error) {
-				return &fakeGNOI{
-					fakeFileClient: &fakeFileClient{
-						statResponses: []any{
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.1.tar.gz",
-								}},
-							},
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.1.tar.gz",
-								}},
-							},
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.1.tar.gz",
-								}, {
-									Path: "/var/core/core.2.tar.gz",
-								}},
-							},
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.1.tar.gz",
-								}},
-							},
-							&fpb.StatResponse{
-								Stats: []*fpb.StatInfo{{
-									Path: "/var/core/core.2.tar.gz",
-								}},
-							},
-						},
-					},
-				}, nil
-			},
-		},
-		afterErr: `Delta Core Files by DUT: 
+		dut: fakeDUT("dut1",
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz", "/var/core/core.2.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.2.tar.gz"),
+		),
+		failOn: "cores",
+		afterErr: `cores health check found changes:
 DUT: dut1
   /var/core/core.2.tar.gz`,
+	}, {
+		desc: "After ignores core found without -health_fail_on_change",
+		dut: fakeDUT("dut1",
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz", "/var/core/core.2.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.2.tar.gz"),
+		),
 	}}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			validator = validatorImpl{
-				duts: map[string]*checker{},
-			}
+			*healthChecksFlag, *healthFailOnChange = "cores", tt.failOn
+			checkers, monitor = map[string]*checker{}, nil
 			e := &eventlis.BeforeTestsEvent{
 				Reservation: &binding.Reservation{
 					DUTs: map[string]binding.DUT{
@@ -476,16 +245,15 @@ DUT: dut1
 					},
 				},
 			}
-			beforeErr := registerBefore(e)
-			if s := errdiff.Check(beforeErr, tt.beforeErr); s != "" {
-				t.Fatalf("registerBefore failed: %v", s)
+			if err := healthBefore(e); err != nil {
+				t.Fatalf("healthBefore failed: %v", err)
 			}
 			aE := &eventlis.AfterTestsEvent{
 				ExitCode: new(int),
 			}
-			afterErr := registerAfter(aE)
+			afterErr := healthAfter(aE)
 			if s := errdiff.Check(afterErr, tt.afterErr); s != "" {
-				t.Fatalf("registerAfter failed: %v", s)
+				t.Fatalf("healthAfter failed: %v", s)
 			}
 		})
 
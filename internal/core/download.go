@@ -0,0 +1,170 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	fpb "github.com/openconfig/gnoi/file"
+	tpb "github.com/openconfig/gnoi/types"
+)
+
+var (
+	coreDownload = flag.Bool("core_download", false, "Download new core files found on DUTs through gNOI File.Get into the test output directory.")
+	coreMaxBytes = flag.Int64("core_download_max_bytes", 2<<30, "Maximum size in bytes of a core file to download; larger core files are only reported.")
+	coreTimeout  = flag.Duration("core_download_timeout", 10*time.Minute, "Maximum time to download one core file.")
+)
+
+// OutputsDir returns the directory for test outputs, which is the -outputs_dir flag
+// defined by fptest if present, or else $TEST_UNDECLARED_OUTPUTS_DIR.  Packages that
+// cannot depend on fptest use it to write test outputs.
+func OutputsDir() string {
+	if f := flag.Lookup("outputs_dir"); f != nil && f.Value.String() != "" {
+		return f.Value.String()
+	}
+	return os.Getenv("TEST_UNDECLARED_OUTPUTS_DIR")
+}
+
+// downloadAll downloads core files of the DUT into dir/cores/<dut>/, keeping their
+// remote path below it, and records the location and checksum of each file.
+func (c *checker) downloadAll(dir string, files coreFiles) {
+	dutDir := filepath.Join(dir, "cores", c.dut.Name())
+	for path, fi := range files {
+		ctx, cancel := context.WithTimeout(context.Background(), *coreTimeout)
+		fi = c.download(ctx, dutDir, fi)
+		cancel()
+		if fi.Local != "" {
+			fi.Local = relPath(dir, fi.Local)
+		}
+		files[path] = fi
+	}
+}
+
+// download fetches a core file into dir, returning the file info with the local path and
+// checksum, or with the download error.
+func (c *checker) download(ctx context.Context, dir string, fi fileInfo) fileInfo {
+	local, sum, err := c.get(ctx, dir, fi)
+	if err != nil {
+		glog.Warningf("DUT %q: unable to download core file %q: %v", c.dut.Name(), fi.Name, err)
+		fi.DownloadErr = err.Error()
+		return fi
+	}
+	glog.Infof("DUT %q: downloaded core file %q to %q (sha256 %s)", c.dut.Name(), fi.Name, local, sum)
+	fi.Local = local
+	fi.SHA256 = sum
+	return fi
+}
+
+var errTooLarge = errors.New("core file exceeds -core_download_max_bytes")
+
+func (c *checker) get(ctx context.Context, dir string, fi fileInfo) (string, string, error) {
+	if *coreMaxBytes > 0 && fi.Size > uint64(*coreMaxBytes) {
+		return "", "", fmt.Errorf("%w: size is %d bytes", errTooLarge, fi.Size)
+	}
+	// Cleaning the path as an absolute one keeps it inside dir.
+	local := filepath.Join(dir, filepath.Clean("/"+fi.Name))
+	if err := os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+		return "", "", err
+	}
+	f, err := os.Create(local)
+	if err != nil {
+		return "", "", err
+	}
+	sum, err := c.copyFile(ctx, f, fi.Name)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(local)
+		return "", "", err
+	}
+	return local, sum, nil
+}
+
+// copyFile streams a remote file into w, verifies the hash reported by the DUT, and
+// returns the hex encoded SHA256 checksum of the contents.
+func (c *checker) copyFile(ctx context.Context, w io.Writer, path string) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.fileClient.Get(ctx, &fpb.GetRequest{RemoteFile: path})
+	if err != nil {
+		return "", err
+	}
+
+	hashes := map[tpb.HashType_HashMethod]hash.Hash{
+		tpb.HashType_SHA256: sha256.New(),
+		tpb.HashType_SHA512: sha512.New(),
+		tpb.HashType_MD5:    md5.New(),
+	}
+	writers := []io.Writer{w}
+	for _, h := range hashes {
+		writers = append(writers, h)
+	}
+	mw := io.MultiWriter(writers...)
+
+	var size int64
+	var want *tpb.HashType
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if h := resp.GetHash(); h != nil {
+			want = h
+			continue
+		}
+		size += int64(len(resp.GetContents()))
+		if *coreMaxBytes > 0 && size > *coreMaxBytes {
+			return "", errTooLarge
+		}
+		if _, err := mw.Write(resp.GetContents()); err != nil {
+			return "", err
+		}
+	}
+
+	if want != nil {
+		if h, ok := hashes[want.GetMethod()]; ok && !bytes.Equal(h.Sum(nil), want.GetHash()) {
+			return "", fmt.Errorf("%v hash mismatch: got %x, DUT reported %x", want.GetMethod(), h.Sum(nil), want.GetHash())
+		}
+	}
+	return hex.EncodeToString(hashes[tpb.HashType_SHA256].Sum(nil)), nil
+}
+
+// relPath returns path relative to dir if possible, for more readable reports.
+func relPath(dir, path string) string {
+	if rel, err := filepath.Rel(dir, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return rel
+	}
+	return path
+}
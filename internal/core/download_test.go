@@ -0,0 +1,193 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/fakebind"
+	"google.golang.org/grpc"
+
+	fpb "github.com/openconfig/gnoi/file"
+	tpb "github.com/openconfig/gnoi/types"
+)
+
+type fakeGetClient struct {
+	grpc.ClientStream
+	responses []*fpb.GetResponse
+}
+
+func (f *fakeGetClient) Recv() (*fpb.GetResponse, error) {
+	if len(f.responses) == 0 {
+		return nil, io.EOF
+	}
+	resp := f.responses[0]
+	f.responses = f.responses[1:]
+	return resp, nil
+}
+
+type fakeGetFileClient struct {
+	fpb.FileClient
+	responses map[string][]*fpb.GetResponse
+}
+
+func (f *fakeGetFileClient) Get(_ context.Context, req *fpb.GetRequest, _ ...grpc.CallOption) (fpb.File_GetClient, error) {
+	return &fakeGetClient{responses: f.responses[req.GetRemoteFile()]}, nil
+}
+
+func getResponses(hash []byte, chunks ...string) []*fpb.GetResponse {
+	var resps []*fpb.GetResponse
+	for _, c := range chunks {
+		resps = append(resps, &fpb.GetResponse{Response: &fpb.GetResponse_Contents{Contents: []byte(c)}})
+	}
+	if hash != nil {
+		resps = append(resps, &fpb.GetResponse{Response: &fpb.GetResponse_Hash{Hash: &tpb.HashType{
+			Method: tpb.HashType_SHA256,
+			Hash:   hash,
+		}}})
+	}
+	return resps
+}
+
+func TestDownload(t *testing.T) {
+	sum := sha256.Sum256([]byte("core contents"))
+	oldSum := sha256.Sum256([]byte("old core"))
+	fileClient := &fakeGetFileClient{
+		responses: map[string][]*fpb.GetResponse{
+			"/var/core/core.1.tar.gz":     getResponses(sum[:], "core ", "contents"),
+			"/var/core/core.2.tar.gz":     getResponses([]byte("bad"), "core ", "contents"),
+			"/var/core/core.3.tar.gz":     getResponses(nil, "0123456789", "0123456789"),
+			"/var/core/old/core.1.tar.gz": getResponses(nil, "old core"),
+		},
+	}
+	c := &checker{
+		dut:        &fakebind.DUT{AbstractDUT: &binding.AbstractDUT{Dims: &binding.Dims{Name: "dut1"}}},
+		fileClient: fileClient,
+	}
+
+	defer func(max int64) { *coreMaxBytes = max }(*coreMaxBytes)
+	*coreMaxBytes = 16
+
+	dir := t.TempDir()
+	got := coreFiles{
+		"/var/core/core.1.tar.gz":     {Name: "/var/core/core.1.tar.gz", Size: 13},
+		"/var/core/core.2.tar.gz":     {Name: "/var/core/core.2.tar.gz", Size: 13},
+		"/var/core/core.3.tar.gz":     {Name: "/var/core/core.3.tar.gz"},
+		"/var/core/core.4.tar.gz":     {Name: "/var/core/core.4.tar.gz", Size: 17},
+		"/var/core/old/core.1.tar.gz": {Name: "/var/core/old/core.1.tar.gz", Size: 8},
+	}
+	c.downloadAll(dir, got)
+
+	want := coreFiles{
+		"/var/core/core.1.tar.gz": {
+			Name:   "/var/core/core.1.tar.gz",
+			Size:   13,
+			Local:  filepath.Join("cores", "dut1", "var", "core", "core.1.tar.gz"),
+			SHA256: hex.EncodeToString(sum[:]),
+		},
+		"/var/core/old/core.1.tar.gz": {
+			Name:   "/var/core/old/core.1.tar.gz",
+			Size:   8,
+			Local:  filepath.Join("cores", "dut1", "var", "core", "old", "core.1.tar.gz"),
+			SHA256: hex.EncodeToString(oldSum[:]),
+		},
+		"/var/core/core.2.tar.gz": {Name: "/var/core/core.2.tar.gz", Size: 13},
+		"/var/core/core.3.tar.gz": {Name: "/var/core/core.3.tar.gz"},
+		"/var/core/core.4.tar.gz": {Name: "/var/core/core.4.tar.gz", Size: 17},
+	}
+	wantErrs := map[string]string{
+		"/var/core/core.2.tar.gz": "hash mismatch",
+		"/var/core/core.3.tar.gz": "exceeds -core_download_max_bytes",
+		"/var/core/core.4.tar.gz": "size is 17 bytes",
+	}
+	for path, fi := range got {
+		var err error
+		if fi.DownloadErr != "" {
+			err = errors.New(fi.DownloadErr)
+		}
+		if diff := errdiff.Substring(err, wantErrs[path]); diff != "" {
+			t.Errorf("download(%q) %s", path, diff)
+		}
+		fi.DownloadErr = ""
+		got[path] = fi
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("downloadAll() unexpected diff (-want,+got):\n%s", diff)
+	}
+
+	for local, want := range map[string]string{
+		"core.1.tar.gz":     "core contents",
+		"old/core.1.tar.gz": "old core",
+	} {
+		b, err := os.ReadFile(filepath.Join(dir, "cores", "dut1", "var", "core", local))
+		if err != nil {
+			t.Fatalf("Unable to read downloaded core file: %v", err)
+		}
+		if got := string(b); got != want {
+			t.Errorf("Downloaded core file %s contents: got %q, want %q", local, got, want)
+		}
+	}
+	for _, name := range []string{"core.2.tar.gz", "core.3.tar.gz", "core.4.tar.gz"} {
+		if _, err := os.Stat(filepath.Join(dir, "cores", "dut1", "var", "core", name)); !os.IsNotExist(err) {
+			t.Errorf("Core file %s should not be kept after a failed download, got err %v", name, err)
+		}
+	}
+}
+
+// blockingGetFileClient streams nothing until the context of the Get is done.
+type blockingGetFileClient struct {
+	fpb.FileClient
+}
+
+type blockingGetClient struct {
+	grpc.ClientStream
+	ctx context.Context
+}
+
+func (b *blockingGetClient) Recv() (*fpb.GetResponse, error) {
+	<-b.ctx.Done()
+	return nil, b.ctx.Err()
+}
+
+func (blockingGetFileClient) Get(ctx context.Context, _ *fpb.GetRequest, _ ...grpc.CallOption) (fpb.File_GetClient, error) {
+	return &blockingGetClient{ctx: ctx}, nil
+}
+
+func TestDownloadTimeout(t *testing.T) {
+	c := &checker{
+		dut:        &fakebind.DUT{AbstractDUT: &binding.AbstractDUT{Dims: &binding.Dims{Name: "dut1"}}},
+		fileClient: blockingGetFileClient{},
+	}
+	defer func(timeout time.Duration) { *coreTimeout = timeout }(*coreTimeout)
+	*coreTimeout = 10 * time.Millisecond
+
+	files := coreFiles{"/var/core/core.1.tar.gz": {Name: "/var/core/core.1.tar.gz"}}
+	c.downloadAll(t.TempDir(), files)
+	if got := files["/var/core/core.1.tar.gz"].DownloadErr; !strings.Contains(got, "deadline exceeded") {
+		t.Errorf("downloadAll() with a stalled download got error %q, want deadline exceeded", got)
+	}
+}
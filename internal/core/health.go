@@ -0,0 +1,259 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/openconfig/ondatra"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/eventlis"
+	"github.com/openconfig/ygnmi/ygnmi"
+)
+
+var (
+	healthChecksFlag    = flag.String("health_checks", "cores", "Comma separated list of DUT health checks to run before and after the tests.  Available: alarms, components, cores, memory, processes.")
+	healthFailOnChange  = flag.String("health_fail_on_change", "cores", "Comma separated list of DUT health checks that fail the test suite when they report a change.")
+	memoryGrowthPercent = flag.Uint("health_memory_growth_percent", 50, "Memory growth of a process in percent reported by the memory health check.")
+)
+
+// HealthCheck checks one aspect of the health of a DUT by comparing a snapshot of its
+// state taken before the tests with one taken after.
+type HealthCheck interface {
+	// Name identifies the check in the -health_checks flag and in suite properties.
+	Name() string
+	// Snapshot captures the state of the DUT.  The gNMI client is dialed to the DUT, or
+	// nil if it cannot be dialed.
+	Snapshot(ctx context.Context, dut binding.DUT, c *ygnmi.Client) (any, error)
+	// Delta describes each unhealthy change between two snapshots.  It returns nothing
+	// if the DUT is as healthy as before.
+	Delta(before, after any) []string
+}
+
+var (
+	healthMu     sync.Mutex
+	healthChecks = map[string]HealthCheck{}
+)
+
+// RegisterHealthCheck makes a health check available to the -health_checks flag.  It
+// panics if a check with the same name is already registered.
+func RegisterHealthCheck(hc HealthCheck) {
+	healthMu.Lock()
+	defer healthMu.Unlock()
+	if _, ok := healthChecks[hc.Name()]; ok {
+		panic(fmt.Sprintf("health check %q is already registered", hc.Name()))
+	}
+	healthChecks[hc.Name()] = hc
+}
+
+func init() {
+	RegisterHealthCheck(coreCheck{})
+	RegisterHealthCheck(processCheck{})
+	RegisterHealthCheck(memoryCheck{})
+	RegisterHealthCheck(componentCheck{})
+	RegisterHealthCheck(alarmCheck{})
+}
+
+// enabledHealthChecks returns the health checks named by the -health_checks flag.
+func enabledHealthChecks() ([]HealthCheck, error) {
+	return namedHealthChecks(*healthChecksFlag)
+}
+
+// namedHealthChecks returns the health checks named in a comma separated list.
+func namedHealthChecks(names string) ([]HealthCheck, error) {
+	healthMu.Lock()
+	defer healthMu.Unlock()
+	var hcs []HealthCheck
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		hc, ok := healthChecks[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown health check %q", name)
+		}
+		hcs = append(hcs, hc)
+	}
+	return hcs, nil
+}
+
+// healthTarget is a DUT monitored by the health checks.
+type healthTarget struct {
+	dut    binding.DUT
+	client *ygnmi.Client
+}
+
+// newHealthTarget returns the health target of a DUT.  If gNMI cannot be dialed, the
+// checks that need it fail, but not the others.
+func newHealthTarget(ctx context.Context, dut binding.DUT) *healthTarget {
+	t := &healthTarget{dut: dut}
+	gnmic, err := dut.DialGNMI(ctx)
+	if err == nil {
+		t.client, err = ygnmi.NewClient(gnmic)
+	}
+	if err != nil {
+		glog.Warningf("DUT %q: failed to dial gNMI for the health checks: %v", dut.Name(), err)
+	}
+	return t
+}
+
+// healthMonitor runs health checks on a set of DUTs.
+type healthMonitor struct {
+	checks  []HealthCheck
+	targets map[string]*healthTarget
+	// before holds the first snapshot keyed by check name and then DUT name.
+	before map[string]map[string]any
+}
+
+func newHealthMonitor(checks []HealthCheck, duts map[string]binding.DUT) *healthMonitor {
+	m := &healthMonitor{
+		checks:  checks,
+		targets: map[string]*healthTarget{},
+	}
+	for _, dut := range duts {
+		m.targets[dut.Name()] = newHealthTarget(context.Background(), dut)
+	}
+	return m
+}
+
+// snapshot takes a snapshot of every DUT for every check concurrently.  Snapshots that
+// fail are logged and left out.
+func (m *healthMonitor) snapshot() map[string]map[string]any {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	snaps := map[string]map[string]any{}
+	for _, hc := range m.checks {
+		snaps[hc.Name()] = map[string]any{}
+		for name, t := range m.targets {
+			wg.Add(1)
+			go func(hc HealthCheck, name string, t *healthTarget) {
+				defer wg.Done()
+				s, err := hc.Snapshot(context.Background(), t.dut, t.client)
+				if err != nil {
+					glog.Warningf("DUT %q: %s health check failed: %v", name, hc.Name(), err)
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				snaps[hc.Name()][name] = s
+			}(hc, name, t)
+		}
+	}
+	wg.Wait()
+	return snaps
+}
+
+// start takes the snapshot that later snapshots are compared against.
+func (m *healthMonitor) start() {
+	m.before = m.snapshot()
+}
+
+// delta takes a new snapshot and returns the changes since start, keyed by check name
+// and then DUT name.  DUTs without changes are left out.
+func (m *healthMonitor) delta() map[string]map[string][]string {
+	after := m.snapshot()
+	deltas := map[string]map[string][]string{}
+	for _, hc := range m.checks {
+		deltas[hc.Name()] = map[string][]string{}
+		for name, b := range m.before[hc.Name()] {
+			a, ok := after[hc.Name()][name]
+			if !ok {
+				continue
+			}
+			if d := hc.Delta(b, a); len(d) > 0 {
+				deltas[hc.Name()][name] = d
+			}
+		}
+	}
+	return deltas
+}
+
+// healthReport formats the changes of one health check by DUT.
+func healthReport(deltas map[string][]string) string {
+	var duts []string
+	for name := range deltas {
+		duts = append(duts, name)
+	}
+	sort.Strings(duts)
+	b := new(strings.Builder)
+	for _, name := range duts {
+		fmt.Fprintf(b, "DUT: %s\n", name)
+		for _, d := range deltas[name] {
+			fmt.Fprintf(b, "  %s\n", d)
+		}
+	}
+	return b.String()
+}
+
+var (
+	monitor *healthMonitor
+	// reserved holds the DUTs of the reservation, for the per-test checks.
+	reserved map[string]binding.DUT
+)
+
+func healthBefore(e *eventlis.BeforeTestsEvent) error {
+	reserved = e.Reservation.DUTs
+	checks, err := enabledHealthChecks()
+	if err != nil {
+		return fmt.Errorf("invalid -health_checks: %w", err)
+	}
+	if _, err := namedHealthChecks(*healthFailOnChange); err != nil {
+		return fmt.Errorf("invalid -health_fail_on_change: %w", err)
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+	monitor = newHealthMonitor(checks, e.Reservation.DUTs)
+	monitor.start()
+	for _, hc := range checks {
+		ondatra.Report().AddSuiteProperty("validator.health."+hc.Name(), "enabled")
+	}
+	return nil
+}
+
+func healthAfter(_ *eventlis.AfterTestsEvent) error {
+	if monitor == nil {
+		return nil
+	}
+	failOn := map[string]bool{}
+	for _, name := range strings.Split(*healthFailOnChange, ",") {
+		failOn[strings.TrimSpace(name)] = true
+	}
+	var failed []string
+	for name, deltas := range monitor.delta() {
+		if len(deltas) == 0 {
+			continue
+		}
+		report := healthReport(deltas)
+		glog.Infof("%s health check found changes:\n%s", name, report)
+		ondatra.Report().AddSuiteProperty("validator.health."+name+".end", report)
+		if failOn[name] {
+			failed = append(failed, fmt.Sprintf("%s health check found changes:\n%s", name, report))
+		}
+	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return errors.New(strings.Join(failed, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ondatra/gnmi/oc/ocpath"
+	"github.com/openconfig/ygnmi/ygnmi"
+)
+
+// errNoGNMI is returned by the snapshots of the checks that need gNMI when it could
+// not be dialed.
+var errNoGNMI = errors.New("gNMI is not available")
+
+// processes is a snapshot of /system/processes keyed by pid.
+type processes map[uint64]*oc.System_Process
+
+func getProcesses(ctx context.Context, c *ygnmi.Client) (processes, error) {
+	if c == nil {
+		return nil, errNoGNMI
+	}
+	ps, err := ygnmi.GetAll(ctx, c, ocpath.Root().System().ProcessAny().State())
+	if err != nil {
+		return nil, err
+	}
+	m := processes{}
+	for _, p := range ps {
+		m[p.GetPid()] = p
+	}
+	return m, nil
+}
+
+// byName returns the pids of the processes with the given name.
+func (ps processes) byName(name string) []uint64 {
+	var pids []uint64
+	for pid, p := range ps {
+		if p.GetName() == name {
+			pids = append(pids, pid)
+		}
+	}
+	sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+	return pids
+}
+
+// processCheck reports processes that restarted or exited, based on their pid and
+// start-time.
+type processCheck struct{}
+
+func (processCheck) Name() string { return "processes" }
+
+func (processCheck) Snapshot(ctx context.Context, _ binding.DUT, c *ygnmi.Client) (any, error) {
+	return getProcesses(ctx, c)
+}
+
+func (processCheck) Delta(before, after any) []string {
+	b, a := before.(processes), after.(processes)
+	var deltas []string
+	for pid, bp := range b {
+		if ap, ok := a[pid]; ok && ap.GetName() == bp.GetName() {
+			if ap.GetStartTime() != bp.GetStartTime() {
+				deltas = append(deltas, fmt.Sprintf("process %q (pid %d) restarted", bp.GetName(), pid))
+			}
+			continue
+		}
+		if pids := a.byName(bp.GetName()); len(pids) > 0 {
+			deltas = append(deltas, fmt.Sprintf("process %q (pid %d) restarted with pid %v", bp.GetName(), pid, pids))
+		} else {
+			deltas = append(deltas, fmt.Sprintf("process %q (pid %d) is no longer running", bp.GetName(), pid))
+		}
+	}
+	sort.Strings(deltas)
+	return deltas
+}
+
+// memoryCheck reports processes whose memory usage grew by more than
+// -health_memory_growth_percent.
+type memoryCheck struct{}
+
+func (memoryCheck) Name() string { return "memory" }
+
+func (memoryCheck) Snapshot(ctx context.Context, _ binding.DUT, c *ygnmi.Client) (any, error) {
+	return getProcesses(ctx, c)
+}
+
+func (memoryCheck) Delta(before, after any) []string {
+	b, a := before.(processes), after.(processes)
+	var deltas []string
+	for pid, bp := range b {
+		ap, ok := a[pid]
+		if !ok || ap.GetName() != bp.GetName() || bp.GetMemoryUsage() == 0 {
+			continue
+		}
+		bm, am := bp.GetMemoryUsage(), ap.GetMemoryUsage()
+		if am <= bm {
+			continue
+		}
+		if growth := (am - bm) * 100 / bm; growth > uint64(*memoryGrowthPercent) {
+			deltas = append(deltas, fmt.Sprintf("process %q (pid %d) memory grew %d%% from %d to %d bytes", bp.GetName(), pid, growth, bm, am))
+		}
+	}
+	sort.Strings(deltas)
+	return deltas
+}
+
+// operStatus is a snapshot of the oper-status of the components keyed by name.
+type operStatus map[string]oc.E_PlatformTypes_COMPONENT_OPER_STATUS
+
+// componentCheck reports components whose oper-status changed to anything other than
+// ACTIVE, or that are no longer present.
+type componentCheck struct{}
+
+func (componentCheck) Name() string { return "components" }
+
+func (componentCheck) Snapshot(ctx context.Context, _ binding.DUT, c *ygnmi.Client) (any, error) {
+	if c == nil {
+		return nil, errNoGNMI
+	}
+	vals, err := ygnmi.LookupAll(ctx, c, ocpath.Root().ComponentAny().OperStatus().State())
+	if err != nil {
+		return nil, err
+	}
+	m := operStatus{}
+	for _, v := range vals {
+		status, ok := v.Val()
+		if !ok {
+			continue
+		}
+		for _, e := range v.Path.GetElem() {
+			if name, ok := e.GetKey()["name"]; ok && e.GetName() == "component" {
+				m[name] = status
+			}
+		}
+	}
+	return m, nil
+}
+
+func (componentCheck) Delta(before, after any) []string {
+	b, a := before.(operStatus), after.(operStatus)
+	var deltas []string
+	for name, bs := range b {
+		as, ok := a[name]
+		switch {
+		case !ok:
+			deltas = append(deltas, fmt.Sprintf("component %q is no longer present", name))
+		case as != bs && as != oc.PlatformTypes_COMPONENT_OPER_STATUS_ACTIVE:
+			deltas = append(deltas, fmt.Sprintf("component %q oper-status changed from %v to %v", name, bs, as))
+		}
+	}
+	sort.Strings(deltas)
+	return deltas
+}
+
+// alarms is a snapshot of /system/alarms keyed by alarm ID.
+type alarms map[string]*oc.System_Alarm
+
+// alarmCheck reports alarms raised since the first snapshot.
+type alarmCheck struct{}
+
+func (alarmCheck) Name() string { return "alarms" }
+
+func (alarmCheck) Snapshot(ctx context.Context, _ binding.DUT, c *ygnmi.Client) (any, error) {
+	if c == nil {
+		return nil, errNoGNMI
+	}
+	vals, err := ygnmi.LookupAll(ctx, c, ocpath.Root().System().AlarmAny().State())
+	if err != nil {
+		return nil, err
+	}
+	m := alarms{}
+	for _, v := range vals {
+		if alarm, ok := v.Val(); ok {
+			m[alarm.GetId()] = alarm
+		}
+	}
+	return m, nil
+}
+
+func (alarmCheck) Delta(before, after any) []string {
+	b, a := before.(alarms), after.(alarms)
+	var deltas []string
+	for id, alarm := range a {
+		if _, ok := b[id]; ok {
+			continue
+		}
+		deltas = append(deltas, fmt.Sprintf("alarm %q raised: %v on %q: %s", id, alarm.GetSeverity(), alarm.GetResource(), alarm.GetText()))
+	}
+	sort.Strings(deltas)
+	return deltas
+}
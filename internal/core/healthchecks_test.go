@@ -0,0 +1,170 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygot/ygot"
+)
+
+func process(pid uint64, name string, start, mem uint64) *oc.System_Process {
+	return &oc.System_Process{
+		Pid:         ygot.Uint64(pid),
+		Name:        ygot.String(name),
+		StartTime:   ygot.Uint64(start),
+		MemoryUsage: ygot.Uint64(mem),
+	}
+}
+
+func TestHealthCheckDelta(t *testing.T) {
+	before := processes{
+		1: process(1, "init", 100, 1000),
+		2: process(2, "bgpd", 200, 1000),
+		3: process(3, "isisd", 300, 1000),
+		4: process(4, "lldpd", 400, 1000),
+		5: process(5, "sshd", 500, 1000),
+	}
+	after := processes{
+		1: process(1, "init", 100, 1000),
+		2: process(2, "bgpd", 250, 1000),
+		6: process(6, "isisd", 600, 1000),
+		5: process(5, "sshd", 500, 3000),
+	}
+	alarmsAfter := alarms{
+		"a1": {Id: ygot.String("a1")},
+		"a2": {
+			Id:       ygot.String("a2"),
+			Severity: oc.AlarmTypes_OPENCONFIG_ALARM_SEVERITY_MAJOR,
+			Resource: ygot.String("PSU1"),
+			Text:     ygot.String("power supply failed"),
+		},
+	}
+
+	tests := []struct {
+		desc          string
+		check         HealthCheck
+		before, after any
+		want          []string
+	}{{
+		desc:   "processes",
+		check:  processCheck{},
+		before: before,
+		after:  after,
+		want: []string{
+			`process "bgpd" (pid 2) restarted`,
+			`process "isisd" (pid 3) restarted with pid [6]`,
+			`process "lldpd" (pid 4) is no longer running`,
+		},
+	}, {
+		desc:   "memory",
+		check:  memoryCheck{},
+		before: before,
+		after:  after,
+		want: []string{
+			`process "sshd" (pid 5) memory grew 200% from 1000 to 3000 bytes`,
+		},
+	}, {
+		desc:  "components",
+		check: componentCheck{},
+		before: operStatus{
+			"linecard1": oc.PlatformTypes_COMPONENT_OPER_STATUS_ACTIVE,
+			"linecard2": oc.PlatformTypes_COMPONENT_OPER_STATUS_DISABLED,
+			"fan1":      oc.PlatformTypes_COMPONENT_OPER_STATUS_ACTIVE,
+			"psu1":      oc.PlatformTypes_COMPONENT_OPER_STATUS_ACTIVE,
+		},
+		after: operStatus{
+			"linecard1": oc.PlatformTypes_COMPONENT_OPER_STATUS_DISABLED,
+			"linecard2": oc.PlatformTypes_COMPONENT_OPER_STATUS_ACTIVE,
+			"psu1":      oc.PlatformTypes_COMPONENT_OPER_STATUS_ACTIVE,
+		},
+		want: []string{
+			`component "fan1" is no longer present`,
+			`component "linecard1" oper-status changed from ACTIVE to DISABLED`,
+		},
+	}, {
+		desc:   "alarms",
+		check:  alarmCheck{},
+		before: alarms{"a1": {Id: ygot.String("a1")}},
+		after:  alarmsAfter,
+		want: []string{
+			`alarm "a2" raised: MAJOR on "PSU1": power supply failed`,
+		},
+	}, {
+		desc:   "no change",
+		check:  processCheck{},
+		before: before,
+		after:  before,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := tt.check.Delta(tt.before, tt.after)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Delta() unexpected diff (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnabledHealthChecks(t *testing.T) {
+	defer func(v string) { *healthChecksFlag = v }(*healthChecksFlag)
+
+	tests := []struct {
+		flag    string
+		want    []string
+		wantErr string
+	}{{
+		flag: "",
+	}, {
+		flag: "processes, alarms",
+		want: []string{"processes", "alarms"},
+	}, {
+		flag:    "processes,bogus",
+		wantErr: `unknown health check "bogus"`,
+	}}
+	for _, tt := range tests {
+		*healthChecksFlag = tt.flag
+		hcs, err := enabledHealthChecks()
+		if diff := errdiff.Substring(err, tt.wantErr); diff != "" {
+			t.Errorf("enabledHealthChecks() with %q: %s", tt.flag, diff)
+		}
+		var got []string
+		for _, hc := range hcs {
+			got = append(got, hc.Name())
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("enabledHealthChecks() with %q unexpected diff (-want,+got):\n%s", tt.flag, diff)
+		}
+	}
+}
+
+func TestHealthReport(t *testing.T) {
+	got := healthReport(map[string][]string{
+		"dut2": {`process "bgpd" (pid 2) restarted`},
+		"dut1": {`alarm "a1" raised`, `alarm "a2" raised`},
+	})
+	want := `DUT: dut1
+  alarm "a1" raised
+  alarm "a2" raised
+DUT: dut2
+  process "bgpd" (pid 2) restarted
+`
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("healthReport() unexpected diff (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var (
+	targetsMu sync.Mutex
+	// perTestTargets caches the health targets of the reserved DUTs across tests.
+	perTestTargets map[string]*healthTarget
+)
+
+// targets returns the health targets of the reserved DUTs, reusing those of the suite
+// level health checks if there are any.
+func targets() map[string]*healthTarget {
+	if monitor != nil {
+		return monitor.targets
+	}
+	targetsMu.Lock()
+	defer targetsMu.Unlock()
+	if perTestTargets == nil {
+		perTestTargets = map[string]*healthTarget{}
+		for _, dut := range reserved {
+			perTestTargets[dut.Name()] = newHealthTarget(context.Background(), dut)
+		}
+	}
+	return perTestTargets
+}
+
+// perTestHealthChecks returns the health checks enabled by -health_checks, and always
+// the cores and processes checks.
+func perTestHealthChecks() ([]HealthCheck, error) {
+	checks, err := enabledHealthChecks()
+	if err != nil {
+		return nil, err
+	}
+	for _, always := range []HealthCheck{coreCheck{}, processCheck{}} {
+		if !slices.ContainsFunc(checks, func(hc HealthCheck) bool { return hc.Name() == always.Name() }) {
+			checks = append(checks, always)
+		}
+	}
+	return checks, nil
+}
+
+// CheckPerTest checks the DUTs for changes reported by the health checks while the
+// test runs, and fails the test if it finds any.  The cores and processes health
+// checks are always run, along with those enabled by -health_checks.
+// It is meant to be called at the start of a subtest, so that a crash is attributed
+// to the subtest that caused it:
+//
+//	t.Run(tc.desc, func(t *testing.T) {
+//		core.CheckPerTest(t)
+//		...
+//	})
+func CheckPerTest(t testing.TB) {
+	t.Helper()
+	checks, err := perTestHealthChecks()
+	if err != nil {
+		t.Fatalf("Invalid -health_checks: %v", err)
+	}
+	hm := &healthMonitor{checks: checks, targets: targets()}
+	hm.start()
+
+	t.Cleanup(func() {
+		var problems []string
+		var names []string
+		deltas := hm.delta()
+		for name := range deltas {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if len(deltas[name]) > 0 {
+				problems = append(problems, name+" health check found changes:\n"+healthReport(deltas[name]))
+			}
+		}
+		if len(problems) > 0 {
+			t.Errorf("DUT health changed during %s:\n%s", t.Name(), strings.Join(problems, "\n"))
+		}
+	})
+}
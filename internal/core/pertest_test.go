@@ -0,0 +1,121 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/fakebind"
+
+	fpb "github.com/openconfig/gnoi/file"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// fakeTB records the failures and cleanups of CheckPerTest.
+type fakeTB struct {
+	testing.TB
+	cleanups []func()
+	errs     []string
+}
+
+func (f *fakeTB) Helper()           {}
+func (f *fakeTB) Name() string      { return "TestFake/subtest" }
+func (f *fakeTB) Cleanup(fn func()) { f.cleanups = append(f.cleanups, fn) }
+func (f *fakeTB) Errorf(format string, args ...any) {
+	f.errs = append(f.errs, fmt.Sprintf(format, args...))
+}
+func (f *fakeTB) Fatalf(format string, args ...any) {
+	f.Errorf(format, args...)
+}
+
+func (f *fakeTB) runCleanups() {
+	for i := len(f.cleanups) - 1; i >= 0; i-- {
+		f.cleanups[i]()
+	}
+}
+
+func statResponse(paths ...string) *fpb.StatResponse {
+	resp := &fpb.StatResponse{}
+	for _, p := range paths {
+		resp.Stats = append(resp.Stats, &fpb.StatInfo{Path: p})
+	}
+	return resp
+}
+
+func TestCheckPerTest(t *testing.T) {
+	tests := []struct {
+		desc    string
+		stats   []any
+		wantErr string
+	}{{
+		desc: "no new cores",
+		stats: []any{
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+		},
+	}, {
+		desc: "new core",
+		stats: []any{
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz", "/var/core/core.2.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.2.tar.gz"),
+		},
+		wantErr: "DUT health changed during TestFake/subtest:\ncores health check found changes:\nDUT: dut1\n  /var/core/core.2.tar.gz",
+	}, {
+		desc: "failed stat is not attributed to the test",
+		stats: []any{
+			fmt.Errorf("gnoi.File.Stat failed"),
+			statResponse("/var/core/core.1.tar.gz", "/var/core/core.2.tar.gz"),
+			statResponse("/var/core/core.1.tar.gz"),
+			statResponse("/var/core/core.2.tar.gz"),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c := &checker{
+				dut: &fakebind.DUT{
+					AbstractDUT: &binding.AbstractDUT{
+						Dims: &binding.Dims{
+							Vendor: opb.Device_ARISTA,
+							Name:   "dut1",
+						},
+					},
+				},
+				fileClient: &fakeFileClient{statResponses: tt.stats},
+			}
+			checkers = map[string]*checker{"dut1": c}
+			monitor, perTestTargets = nil, map[string]*healthTarget{"dut1": {dut: c.dut}}
+
+			tb := &fakeTB{}
+			CheckPerTest(tb)
+			tb.runCleanups()
+
+			got := strings.Join(tb.errs, "\n")
+			if tt.wantErr == "" && got != "" {
+				t.Errorf("CheckPerTest() unexpected failure: %s", got)
+			}
+			if !strings.Contains(got, tt.wantErr) {
+				t.Errorf("CheckPerTest() got failure %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
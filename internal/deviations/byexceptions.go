@@ -24,6 +24,8 @@ import (
 // NOTE: Flags here should be added by exception only.
 // All flags should have a corresponding field in the Deviations message in the metadata.proto file.
 // If a flag value is set, that will take precedence over the metadata value.
+// To override other deviations, e.g. during bring-up of new hardware, use the
+// -deviations_override flag instead of adding a flag here.
 var (
 	cpuMissingAncestor                       = flag.Bool("deviation_cpu_missing_ancestor", false, "Set to true for devices where the CPU components do not map to a FRU parent component in the OC tree.")
 	interfaceRefConfigUnsupported            = flag.Bool("deviation_interface_ref_config_unsupported", false, "Set to true for devices that do not support interface-ref configuration when applying features to interface.")
@@ -50,77 +52,77 @@ func isFlagSet(name string) bool {
 // do not map to a FRU parent component in the OC tree.
 func CPUMissingAncestor(dut *ondatra.DUTDevice) bool {
 	if isFlagSet("deviation_cpu_missing_ancestor") {
-		return *cpuMissingAncestor
+		return recorded(dut.ID(), "cpu_missing_ancestor", *cpuMissingAncestor)
 	}
-	return lookupDUTDeviations(dut).GetCpuMissingAncestor()
+	return recorded(dut.ID(), "cpu_missing_ancestor", lookupDUTDeviations(dut).GetCpuMissingAncestor())
 }
 
 // InterfaceRefConfigUnsupported deviation set to true for devices that do not support
 // interface-ref configuration when applying features to interface.
 func InterfaceRefConfigUnsupported(dut *ondatra.DUTDevice) bool {
 	if isFlagSet("deviation_interface_ref_config_unsupported") {
-		return *interfaceRefConfigUnsupported
+		return recorded(dut.ID(), "interface_ref_config_unsupported", *interfaceRefConfigUnsupported)
 	}
-	return lookupDUTDeviations(dut).GetInterfaceRefConfigUnsupported()
+	return recorded(dut.ID(), "interface_ref_config_unsupported", lookupDUTDeviations(dut).GetInterfaceRefConfigUnsupported())
 }
 
 // RequireRoutedSubinterface0 returns true if device needs to configure subinterface 0
 // for non-zero sub-interfaces.
 func RequireRoutedSubinterface0(dut *ondatra.DUTDevice) bool {
 	if isFlagSet("deviation_require_routed_subinterface_0") {
-		return *requireRoutedSubinterface0
+		return recorded(dut.ID(), "require_routed_subinterface_0", *requireRoutedSubinterface0)
 	}
-	return lookupDUTDeviations(dut).GetRequireRoutedSubinterface_0()
+	return recorded(dut.ID(), "require_routed_subinterface_0", lookupDUTDeviations(dut).GetRequireRoutedSubinterface_0())
 }
 
 // GNOISwitchoverReasonMissingUserInitiated returns true for devices that don't
 // report last-switchover-reason as USER_INITIATED for gNOI.SwitchControlProcessor.
 func GNOISwitchoverReasonMissingUserInitiated(dut *ondatra.DUTDevice) bool {
 	if isFlagSet("deviation_gnoi_switchover_reason_missing_user_initiated") {
-		return *gnoiSwitchoverReasonMissingUserInitiated
+		return recorded(dut.ID(), "gnoi_switchover_reason_missing_user_initiated", *gnoiSwitchoverReasonMissingUserInitiated)
 	}
-	return lookupDUTDeviations(dut).GetGnoiSwitchoverReasonMissingUserInitiated()
+	return recorded(dut.ID(), "gnoi_switchover_reason_missing_user_initiated", lookupDUTDeviations(dut).GetGnoiSwitchoverReasonMissingUserInitiated())
 }
 
 // P4rtUnsetElectionIDPrimaryAllowed returns whether the device does not support unset election ID.
 func P4rtUnsetElectionIDPrimaryAllowed(dut *ondatra.DUTDevice) bool {
 	if isFlagSet("deviation_p4rt_unsetelectionid_primary_allowed") {
-		return *p4rtUnsetElectionIDPrimaryAllowed
+		return recorded(dut.ID(), "p4rt_unsetelectionid_primary_allowed", *p4rtUnsetElectionIDPrimaryAllowed)
 	}
-	return lookupDUTDeviations(dut).GetP4RtUnsetelectionidPrimaryAllowed()
+	return recorded(dut.ID(), "p4rt_unsetelectionid_primary_allowed", lookupDUTDeviations(dut).GetP4RtUnsetelectionidPrimaryAllowed())
 }
 
 // P4rtBackupArbitrationResponseCode returns whether the device does not support unset election ID.
 func P4rtBackupArbitrationResponseCode(dut *ondatra.DUTDevice) bool {
 	if isFlagSet("deviation_bkup_arbitration_resp_code") {
-		return *p4rtBackupArbitrationResponseCode
+		return recorded(dut.ID(), "bkup_arbitration_resp_code", *p4rtBackupArbitrationResponseCode)
 	}
-	return lookupDUTDeviations(dut).GetBkupArbitrationRespCode()
+	return recorded(dut.ID(), "bkup_arbitration_resp_code", lookupDUTDeviations(dut).GetBkupArbitrationRespCode())
 }
 
 // BackupNHGRequiresVrfWithDecap returns true for devices that require
 // IPOverIP Decapsulation for Backup NHG without interfaces.
 func BackupNHGRequiresVrfWithDecap(dut *ondatra.DUTDevice) bool {
 	if isFlagSet("deviation_backup_nhg_requires_vrf_with_decap") {
-		return *backupNHGRequiresVrfWithDecap
+		return recorded(dut.ID(), "backup_nhg_requires_vrf_with_decap", *backupNHGRequiresVrfWithDecap)
 	}
-	return lookupDUTDeviations(dut).GetBackupNhgRequiresVrfWithDecap()
+	return recorded(dut.ID(), "backup_nhg_requires_vrf_with_decap", lookupDUTDeviations(dut).GetBackupNhgRequiresVrfWithDecap())
 }
 
 // ATEPortLinkStateOperationsUnsupported returns true for traffic generators that do not support
 // port link state control operations (such as port shutdown.)
 func ATEPortLinkStateOperationsUnsupported(ate *ondatra.ATEDevice) bool {
 	if isFlagSet("deviation_ate_port_link_state_operations_unsupported") {
-		return *atePortLinkStateOperationsUnsupported
+		return recorded(ate.ID(), "ate_port_link_state_operations_unsupported", *atePortLinkStateOperationsUnsupported)
 	}
-	return lookupATEDeviations(ate).GetAtePortLinkStateOperationsUnsupported()
+	return recorded(ate.ID(), "ate_port_link_state_operations_unsupported", lookupATEDeviations(ate).GetAtePortLinkStateOperationsUnsupported())
 }
 
 // ATEIPv6FlowLabelUnsupported returns true for traffic generators that do not support
 // IPv6 flow labels
 func ATEIPv6FlowLabelUnsupported(ate *ondatra.ATEDevice) bool {
 	if isFlagSet("deviation_ate_ipv6_flow_label_unsupported") {
-		return *ateIPv6FlowLabelUnsupported
+		return recorded(ate.ID(), "ate_ipv6_flow_label_unsupported", *ateIPv6FlowLabelUnsupported)
 	}
-	return lookupATEDeviations(ate).GetAteIpv6FlowLabelUnsupported()
+	return recorded(ate.ID(), "ate_ipv6_flow_label_unsupported", lookupATEDeviations(ate).GetAteIpv6FlowLabelUnsupported())
 }
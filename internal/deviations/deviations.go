@@ -46,29 +46,43 @@ package deviations
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	log "github.com/golang/glog"
 	"github.com/openconfig/featureprofiles/internal/metadata"
+	"google.golang.org/protobuf/proto"
 
 	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
 	"github.com/openconfig/ondatra"
 )
 
-func lookupDeviations(dvc *ondatra.Device) (*mpb.Metadata_PlatformExceptions, error) {
+func lookupDeviations(dvc *ondatra.Device) (*mpb.Metadata_Deviations, error) {
+	return matchDeviations(metadata.Get().GetPlatformExceptions(), dvc.Vendor().String(), dvc.Model(), dvc.Version())
+}
+
+// matchDeviations returns the deviations of the platform_exceptions matching a
+// device, or nil if none match.  It follows the same rule as
+// ValidatePlatformExceptions: entries that set no deviations are ignored, and
+// several entries may match as long as their deviations do not conflict.
+func matchDeviations(pes []*mpb.Metadata_PlatformExceptions, vendor, model, version string) (*mpb.Metadata_Deviations, error) {
 	var matchedPlatformException *mpb.Metadata_PlatformExceptions
 
-	for _, platformExceptions := range metadata.Get().GetPlatformExceptions() {
+	for _, platformExceptions := range pes {
+		if proto.Size(platformExceptions.GetDeviations()) == 0 {
+			continue
+		}
+
 		if platformExceptions.GetPlatform().GetVendor().String() == "" {
 			return nil, fmt.Errorf("vendor should be specified in textproto %v", platformExceptions)
 		}
 
-		if dvc.Vendor().String() != platformExceptions.GetPlatform().GetVendor().String() {
+		if vendor != platformExceptions.GetPlatform().GetVendor().String() {
 			continue
 		}
 
 		// If hardware_model_regex is set and does not match, continue
 		if hardwareModelRegex := platformExceptions.GetPlatform().GetHardwareModelRegex(); hardwareModelRegex != "" {
-			matchHw, errHw := regexp.MatchString(hardwareModelRegex, dvc.Model())
+			matchHw, errHw := regexp.MatchString(hardwareModelRegex, model)
 			if errHw != nil {
 				return nil, fmt.Errorf("error with regex match %v", errHw)
 			}
@@ -79,7 +93,7 @@ func lookupDeviations(dvc *ondatra.Device) (*mpb.Metadata_PlatformExceptions, er
 
 		// If software_version_regex is set and does not match, continue
 		if softwareVersionRegex := platformExceptions.GetPlatform().GetSoftwareVersionRegex(); softwareVersionRegex != "" {
-			matchSw, errSw := regexp.MatchString(softwareVersionRegex, dvc.Version())
+			matchSw, errSw := regexp.MatchString(softwareVersionRegex, version)
 			if errSw != nil {
 				return nil, fmt.Errorf("error with regex match %v", errSw)
 			}
@@ -89,23 +103,27 @@ func lookupDeviations(dvc *ondatra.Device) (*mpb.Metadata_PlatformExceptions, er
 		}
 
 		if matchedPlatformException != nil {
-			return nil, fmt.Errorf("cannot have more than one match within platform_exceptions fields %v and %v", matchedPlatformException, platformExceptions)
+			if conflicts := conflictingDeviations(matchedPlatformException.GetDeviations(), platformExceptions.GetDeviations()); len(conflicts) > 0 {
+				return nil, fmt.Errorf("platform_exceptions fields %v and %v both match with conflicting deviations: %s", matchedPlatformException, platformExceptions, strings.Join(conflicts, ", "))
+			}
+			continue
 		}
 		matchedPlatformException = platformExceptions
 	}
-	return matchedPlatformException, nil
+	return matchedPlatformException.GetDeviations(), nil
 }
 
 func mustLookupDeviations(dvc *ondatra.Device) *mpb.Metadata_Deviations {
-	platformExceptions, err := lookupDeviations(dvc)
+	mustValidate()
+	deviations, err := lookupDeviations(dvc)
 	if err != nil {
 		log.Exitf("Error looking up deviations: %v", err)
 	}
-	if platformExceptions == nil {
+	if deviations == nil {
 		log.Infof("Did not match any platform_exception %v, returning default values", metadata.Get().GetPlatformExceptions())
-		return &mpb.Metadata_Deviations{}
+		return applyOverrides(dvc.ID(), &mpb.Metadata_Deviations{})
 	}
-	return platformExceptions.GetDeviations()
+	return applyOverrides(dvc.ID(), deviations)
 }
 
 func lookupDUTDeviations(dut *ondatra.DUTDevice) *mpb.Metadata_Deviations {
@@ -119,29 +137,29 @@ func lookupATEDeviations(ate *ondatra.ATEDevice) *mpb.Metadata_Deviations {
 // BannerDelimiter returns if device requires the banner to have a delimiter character.
 // Full OpenConfig compliant devices should work without delimiter.
 func BannerDelimiter(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetBannerDelimiter()
+	return recorded(dut.ID(), "banner_delimiter", lookupDUTDeviations(dut).GetBannerDelimiter())
 }
 
 // OmitL2MTU returns if device does not support setting the L2 MTU.
 func OmitL2MTU(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOmitL2Mtu()
+	return recorded(dut.ID(), "omit_l2_mtu", lookupDUTDeviations(dut).GetOmitL2Mtu())
 }
 
 // GRIBIMACOverrideStaticARPStaticRoute returns whether the device needs to configure Static ARP + Static Route to override setting MAC address in Next Hop.
 func GRIBIMACOverrideStaticARPStaticRoute(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGribiMacOverrideStaticArpStaticRoute()
+	return recorded(dut.ID(), "gribi_mac_override_static_arp_static_route", lookupDUTDeviations(dut).GetGribiMacOverrideStaticArpStaticRoute())
 }
 
 // AggregateAtomicUpdate returns if device requires that aggregate Port-Channel and its members be defined in a single gNMI Update transaction at /interfaces,
 // Otherwise lag-type will be dropped, and no member can be added to the aggregate.
 // Full OpenConfig compliant devices should pass both with and without this deviation.
 func AggregateAtomicUpdate(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAggregateAtomicUpdate()
+	return recorded(dut.ID(), "aggregate_atomic_update", lookupDUTDeviations(dut).GetAggregateAtomicUpdate())
 }
 
 // DefaultNetworkInstance returns the name used for the default network instance for VRF.
 func DefaultNetworkInstance(dut *ondatra.DUTDevice) string {
-	if dni := lookupDUTDeviations(dut).GetDefaultNetworkInstance(); dni != "" {
+	if dni := recorded(dut.ID(), "default_network_instance", lookupDUTDeviations(dut).GetDefaultNetworkInstance()); dni != "" {
 		return dni
 	}
 	return "DEFAULT"
@@ -149,42 +167,42 @@ func DefaultNetworkInstance(dut *ondatra.DUTDevice) string {
 
 // ISISRestartSuppressUnsupported returns whether the device should skip isis restart-suppress check.
 func ISISRestartSuppressUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisRestartSuppressUnsupported()
+	return recorded(dut.ID(), "isis_restart_suppress_unsupported", lookupDUTDeviations(dut).GetIsisRestartSuppressUnsupported())
 }
 
 // BgpGrHelperDisableUnsupported returns whether the device does not support to disable BGP GR Helper.
 func BgpGrHelperDisableUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpGrHelperDisableUnsupported()
+	return recorded(dut.ID(), "bgp_gr_helper_disable_unsupported", lookupDUTDeviations(dut).GetBgpGrHelperDisableUnsupported())
 }
 
 // BgpGracefulRestartUnderAfiSafiUnsupported returns whether the device does not support bgp GR-RESTART under AFI/SAFI.
 func BgpGracefulRestartUnderAfiSafiUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpGracefulRestartUnderAfiSafiUnsupported()
+	return recorded(dut.ID(), "bgp_graceful_restart_under_afi_safi_unsupported", lookupDUTDeviations(dut).GetBgpGracefulRestartUnderAfiSafiUnsupported())
 }
 
 // MissingBgpLastNotificationErrorCode returns whether the last-notification-error-code leaf is missing in bgp.
 func MissingBgpLastNotificationErrorCode(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMissingBgpLastNotificationErrorCode()
+	return recorded(dut.ID(), "missing_bgp_last_notification_error_code", lookupDUTDeviations(dut).GetMissingBgpLastNotificationErrorCode())
 }
 
 // GRIBIMACOverrideWithStaticARP returns whether for a gRIBI IPv4 route the device does not support a mac-address only next-hop-entry.
 func GRIBIMACOverrideWithStaticARP(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGribiMacOverrideWithStaticArp()
+	return recorded(dut.ID(), "gribi_mac_override_with_static_arp", lookupDUTDeviations(dut).GetGribiMacOverrideWithStaticArp())
 }
 
 // CLITakesPrecedenceOverOC returns whether config pushed through origin CLI takes precedence over config pushed through origin OC.
 func CLITakesPrecedenceOverOC(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetCliTakesPrecedenceOverOc()
+	return recorded(dut.ID(), "cli_takes_precedence_over_oc", lookupDUTDeviations(dut).GetCliTakesPrecedenceOverOc())
 }
 
 // BGPTrafficTolerance returns the allowed tolerance for BGP traffic flow while comparing for pass or fail conditions.
 func BGPTrafficTolerance(dut *ondatra.DUTDevice) int32 {
-	return lookupDUTDeviations(dut).GetBgpToleranceValue()
+	return recorded(dut.ID(), "bgp_tolerance_value", lookupDUTDeviations(dut).GetBgpToleranceValue())
 }
 
 // StaticProtocolName returns the name used for the static routing protocol.
 func StaticProtocolName(dut *ondatra.DUTDevice) string {
-	if spn := lookupDUTDeviations(dut).GetStaticProtocolName(); spn != "" {
+	if spn := recorded(dut.ID(), "static_protocol_name", lookupDUTDeviations(dut).GetStaticProtocolName()); spn != "" {
 		return spn
 	}
 	return "DEFAULT"
@@ -192,84 +210,84 @@ func StaticProtocolName(dut *ondatra.DUTDevice) string {
 
 // SwitchChipIDUnsupported returns whether the device supports id leaf for SwitchChip components.
 func SwitchChipIDUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSwitchChipIdUnsupported()
+	return recorded(dut.ID(), "switch_chip_id_unsupported", lookupDUTDeviations(dut).GetSwitchChipIdUnsupported())
 }
 
 // BackplaneFacingCapacityUnsupported returns whether the device supports backplane-facing-capacity leaves for some components.
 func BackplaneFacingCapacityUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBackplaneFacingCapacityUnsupported()
+	return recorded(dut.ID(), "backplane_facing_capacity_unsupported", lookupDUTDeviations(dut).GetBackplaneFacingCapacityUnsupported())
 }
 
 // SchedulerInputWeightLimit returns whether the device does not support weight above 100.
 func SchedulerInputWeightLimit(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSchedulerInputWeightLimit()
+	return recorded(dut.ID(), "scheduler_input_weight_limit", lookupDUTDeviations(dut).GetSchedulerInputWeightLimit())
 }
 
 // ECNProfileRequiredDefinition returns whether the device requires additional config for ECN.
 func ECNProfileRequiredDefinition(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetEcnProfileRequiredDefinition()
+	return recorded(dut.ID(), "ecn_profile_required_definition", lookupDUTDeviations(dut).GetEcnProfileRequiredDefinition())
 }
 
 // ISISGlobalAuthenticationNotRequired returns true if ISIS Global authentication not required.
 func ISISGlobalAuthenticationNotRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisGlobalAuthenticationNotRequired()
+	return recorded(dut.ID(), "isis_global_authentication_not_required", lookupDUTDeviations(dut).GetIsisGlobalAuthenticationNotRequired())
 }
 
 // ISISExplicitLevelAuthenticationConfig returns true if ISIS Explicit Level Authentication configuration is required
 func ISISExplicitLevelAuthenticationConfig(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisExplicitLevelAuthenticationConfig()
+	return recorded(dut.ID(), "isis_explicit_level_authentication_config", lookupDUTDeviations(dut).GetIsisExplicitLevelAuthenticationConfig())
 }
 
 // ISISSingleTopologyRequired sets isis af ipv6 single topology on the device if value is true.
 func ISISSingleTopologyRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisSingleTopologyRequired()
+	return recorded(dut.ID(), "isis_single_topology_required", lookupDUTDeviations(dut).GetIsisSingleTopologyRequired())
 }
 
 // ISISMultiTopologyUnsupported returns if device skips isis multi-topology check.
 func ISISMultiTopologyUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisMultiTopologyUnsupported()
+	return recorded(dut.ID(), "isis_multi_topology_unsupported", lookupDUTDeviations(dut).GetIsisMultiTopologyUnsupported())
 }
 
 // ISISInterfaceLevel1DisableRequired returns if device should disable isis level1 under interface mode.
 func ISISInterfaceLevel1DisableRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisInterfaceLevel1DisableRequired()
+	return recorded(dut.ID(), "isis_interface_level1_disable_required", lookupDUTDeviations(dut).GetIsisInterfaceLevel1DisableRequired())
 }
 
 // MissingIsisInterfaceAfiSafiEnable returns if device should set and validate isis interface address family enable.
 // Default is validate isis address family enable at global mode.
 func MissingIsisInterfaceAfiSafiEnable(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMissingIsisInterfaceAfiSafiEnable()
+	return recorded(dut.ID(), "missing_isis_interface_afi_safi_enable", lookupDUTDeviations(dut).GetMissingIsisInterfaceAfiSafiEnable())
 }
 
 // Ipv6DiscardedPktsUnsupported returns whether the device supports interface ipv6 discarded packet stats.
 func Ipv6DiscardedPktsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIpv6DiscardedPktsUnsupported()
+	return recorded(dut.ID(), "ipv6_discarded_pkts_unsupported", lookupDUTDeviations(dut).GetIpv6DiscardedPktsUnsupported())
 }
 
 // LinkQualWaitAfterDeleteRequired returns whether the device requires additional time to complete post delete link qualification cleanup.
 func LinkQualWaitAfterDeleteRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLinkQualWaitAfterDeleteRequired()
+	return recorded(dut.ID(), "link_qual_wait_after_delete_required", lookupDUTDeviations(dut).GetLinkQualWaitAfterDeleteRequired())
 }
 
 // StatePathsUnsupported returns whether the device supports following state paths
 func StatePathsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStatePathUnsupported()
+	return recorded(dut.ID(), "state_path_unsupported", lookupDUTDeviations(dut).GetStatePathUnsupported())
 }
 
 // DropWeightLeavesUnsupported returns whether the device supports drop and weight leaves under queue management profile.
 func DropWeightLeavesUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDropWeightLeavesUnsupported()
+	return recorded(dut.ID(), "drop_weight_leaves_unsupported", lookupDUTDeviations(dut).GetDropWeightLeavesUnsupported())
 }
 
 // SwVersionUnsupported returns true if the device does not support reporting software version according to the requirements in gNMI-1.10.
 func SwVersionUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSwVersionUnsupported()
+	return recorded(dut.ID(), "sw_version_unsupported", lookupDUTDeviations(dut).GetSwVersionUnsupported())
 }
 
 // HierarchicalWeightResolutionTolerance returns the allowed tolerance for BGP traffic flow while comparing for pass or fail conditions.
 // Default minimum value is 0.2. Anything less than 0.2 will be set to 0.2.
 func HierarchicalWeightResolutionTolerance(dut *ondatra.DUTDevice) float64 {
-	hwrt := lookupDUTDeviations(dut).GetHierarchicalWeightResolutionTolerance()
+	hwrt := recorded(dut.ID(), "hierarchical_weight_resolution_tolerance", lookupDUTDeviations(dut).GetHierarchicalWeightResolutionTolerance())
 	if minHWRT := 0.2; hwrt < minHWRT {
 		return minHWRT
 	}
@@ -278,46 +296,46 @@ func HierarchicalWeightResolutionTolerance(dut *ondatra.DUTDevice) float64 {
 
 // InterfaceEnabled returns if device requires interface enabled leaf booleans to be explicitly set to true.
 func InterfaceEnabled(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceEnabled()
+	return recorded(dut.ID(), "interface_enabled", lookupDUTDeviations(dut).GetInterfaceEnabled())
 }
 
 // InterfaceCountersFromContainer returns if the device only supports querying counters from the state container, not from individual counter leaves.
 func InterfaceCountersFromContainer(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceCountersFromContainer()
+	return recorded(dut.ID(), "interface_counters_from_container", lookupDUTDeviations(dut).GetInterfaceCountersFromContainer())
 }
 
 // IPv4MissingEnabled returns if device does not support interface/ipv4/enabled.
 func IPv4MissingEnabled(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIpv4MissingEnabled()
+	return recorded(dut.ID(), "ipv4_missing_enabled", lookupDUTDeviations(dut).GetIpv4MissingEnabled())
 }
 
 // IPNeighborMissing returns true if the device does not support interface/ipv4(6)/neighbor,
 // so test can suppress the related check for interface/ipv4(6)/neighbor.
 func IPNeighborMissing(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIpNeighborMissing()
+	return recorded(dut.ID(), "ip_neighbor_missing", lookupDUTDeviations(dut).GetIpNeighborMissing())
 }
 
 // GRIBIRIBAckOnly returns if device only supports RIB ack, so tests that normally expect FIB_ACK will allow just RIB_ACK.
 // Full gRIBI compliant devices should pass both with and without this deviation.
 func GRIBIRIBAckOnly(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGribiRibackOnly()
+	return recorded(dut.ID(), "gribi_riback_only", lookupDUTDeviations(dut).GetGribiRibackOnly())
 }
 
 // MissingValueForDefaults returns if device returns no value for some OpenConfig paths if the operational value equals the default.
 func MissingValueForDefaults(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMissingValueForDefaults()
+	return recorded(dut.ID(), "missing_value_for_defaults", lookupDUTDeviations(dut).GetMissingValueForDefaults())
 }
 
 // TraceRouteL4ProtocolUDP returns if device only support UDP as l4 protocol for traceroute.
 // Default value is false.
 func TraceRouteL4ProtocolUDP(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTracerouteL4ProtocolUdp()
+	return recorded(dut.ID(), "traceroute_l4_protocol_udp", lookupDUTDeviations(dut).GetTracerouteL4ProtocolUdp())
 }
 
 // LLDPInterfaceConfigOverrideGlobal returns if LLDP interface config should override the global config,
 // expect neighbours are seen when lldp is disabled globally but enabled on interface
 func LLDPInterfaceConfigOverrideGlobal(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLldpInterfaceConfigOverrideGlobal()
+	return recorded(dut.ID(), "lldp_interface_config_override_global", lookupDUTDeviations(dut).GetLldpInterfaceConfigOverrideGlobal())
 }
 
 // SubinterfacePacketCountersMissing returns if device is missing subinterface packet counters for IPv4/IPv6,
@@ -325,861 +343,861 @@ func LLDPInterfaceConfigOverrideGlobal(dut *ondatra.DUTDevice) bool {
 // Full OpenConfig compliant devices should pass both with and without this deviation.
 // Nokia https://b.corp.google.com/issues/4778051566
 func SubinterfacePacketCountersMissing(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSubinterfacePacketCountersMissing()
+	return recorded(dut.ID(), "subinterface_packet_counters_missing", lookupDUTDeviations(dut).GetSubinterfacePacketCountersMissing())
 }
 
 // DefaultSubinterfacePacketCountersMissing returns if device is missing subinterface state packet counters.
 // Default value is false.
 func DefaultSubinterfacePacketCountersMissing(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDefaultSubinterfacePacketCountersMissing()
+	return recorded(dut.ID(), "default_subinterface_packet_counters_missing", lookupDUTDeviations(dut).GetDefaultSubinterfacePacketCountersMissing())
 }
 
 // MissingPrePolicyReceivedRoutes returns if device does not support bgp/neighbors/neighbor/afi-safis/afi-safi/state/prefixes/received-pre-policy.
 // Fully-compliant devices should pass with and without this deviation.
 func MissingPrePolicyReceivedRoutes(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPrepolicyReceivedRoutes()
+	return recorded(dut.ID(), "prepolicy_received_routes", lookupDUTDeviations(dut).GetPrepolicyReceivedRoutes())
 }
 
 // DeprecatedVlanID returns if device requires using the deprecated openconfig-vlan:vlan/config/vlan-id or openconfig-vlan:vlan/state/vlan-id leaves.
 func DeprecatedVlanID(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDeprecatedVlanId()
+	return recorded(dut.ID(), "deprecated_vlan_id", lookupDUTDeviations(dut).GetDeprecatedVlanId())
 }
 
 // OSActivateNoReboot returns if device requires separate reboot to activate OS.
 func OSActivateNoReboot(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOsactivateNoreboot()
+	return recorded(dut.ID(), "osactivate_noreboot", lookupDUTDeviations(dut).GetOsactivateNoreboot())
 }
 
 // ConnectRetry returns if /bgp/neighbors/neighbor/timers/config/connect-retry is not supported.
 func ConnectRetry(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetConnectRetry()
+	return recorded(dut.ID(), "connect_retry", lookupDUTDeviations(dut).GetConnectRetry())
 }
 
 // InstallOSForStandbyRP returns if device requires OS installation on standby RP as well as active RP.
 func InstallOSForStandbyRP(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOsinstallForStandbyRp()
+	return recorded(dut.ID(), "osinstall_for_standby_rp", lookupDUTDeviations(dut).GetOsinstallForStandbyRp())
 }
 
 // GNOIStatusWithEmptySubcomponent returns if the response of gNOI reboot status is a single value (not a list),
 // the device requires explicit component path to account for a situation when there is more than one active reboot requests.
 func GNOIStatusWithEmptySubcomponent(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGnoiStatusEmptySubcomponent()
+	return recorded(dut.ID(), "gnoi_status_empty_subcomponent", lookupDUTDeviations(dut).GetGnoiStatusEmptySubcomponent())
 }
 
 // NetworkInstanceTableDeletionRequired returns if device requires explicit deletion of network-instance table.
 func NetworkInstanceTableDeletionRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNetworkInstanceTableDeletionRequired()
+	return recorded(dut.ID(), "network_instance_table_deletion_required", lookupDUTDeviations(dut).GetNetworkInstanceTableDeletionRequired())
 }
 
 // ExplicitPortSpeed returns if device requires port-speed to be set because its default value may not be usable.
 // Fully compliant devices selects the highest speed available based on negotiation.
 func ExplicitPortSpeed(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetExplicitPortSpeed()
+	return recorded(dut.ID(), "explicit_port_speed", lookupDUTDeviations(dut).GetExplicitPortSpeed())
 }
 
 // ExplicitInterfaceInDefaultVRF returns if device requires explicit attachment of an interface or subinterface to the default network instance.
 // OpenConfig expects an unattached interface or subinterface to be implicitly part of the default network instance.
 // Fully-compliant devices should pass with and without this deviation.
 func ExplicitInterfaceInDefaultVRF(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetExplicitInterfaceInDefaultVrf()
+	return recorded(dut.ID(), "explicit_interface_in_default_vrf", lookupDUTDeviations(dut).GetExplicitInterfaceInDefaultVrf())
 }
 
 // RibWecmp returns if device requires CLI knob to enable wecmp feature.
 func RibWecmp(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetRibWecmp()
+	return recorded(dut.ID(), "rib_wecmp", lookupDUTDeviations(dut).GetRibWecmp())
 }
 
 // InterfaceConfigVRFBeforeAddress returns if vrf should be configured before IP address when configuring interface.
 func InterfaceConfigVRFBeforeAddress(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceConfigVrfBeforeAddress()
+	return recorded(dut.ID(), "interface_config_vrf_before_address", lookupDUTDeviations(dut).GetInterfaceConfigVrfBeforeAddress())
 }
 
 // BGPMD5RequiresReset returns if device requires a BGP session reset to utilize a new MD5 key.
 func BGPMD5RequiresReset(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpMd5RequiresReset()
+	return recorded(dut.ID(), "bgp_md5_requires_reset", lookupDUTDeviations(dut).GetBgpMd5RequiresReset())
 }
 
 // ExplicitIPv6EnableForGRIBI returns if device requires Ipv6 to be enabled on interface for gRIBI NH programmed with destination mac address.
 func ExplicitIPv6EnableForGRIBI(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIpv6EnableForGribiNhDmac()
+	return recorded(dut.ID(), "ipv6_enable_for_gribi_nh_dmac", lookupDUTDeviations(dut).GetIpv6EnableForGribiNhDmac())
 }
 
 // ISISInstanceEnabledRequired returns if isis instance name string should be set on the device.
 func ISISInstanceEnabledRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisInstanceEnabledRequired()
+	return recorded(dut.ID(), "isis_instance_enabled_required", lookupDUTDeviations(dut).GetIsisInstanceEnabledRequired())
 }
 
 // GNOISubcomponentPath returns if device currently uses component name instead of a full openconfig path.
 func GNOISubcomponentPath(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGnoiSubcomponentPath()
+	return recorded(dut.ID(), "gnoi_subcomponent_path", lookupDUTDeviations(dut).GetGnoiSubcomponentPath())
 }
 
 // NoMixOfTaggedAndUntaggedSubinterfaces returns if device does not support a mix of tagged and untagged subinterfaces
 func NoMixOfTaggedAndUntaggedSubinterfaces(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNoMixOfTaggedAndUntaggedSubinterfaces()
+	return recorded(dut.ID(), "no_mix_of_tagged_and_untagged_subinterfaces", lookupDUTDeviations(dut).GetNoMixOfTaggedAndUntaggedSubinterfaces())
 }
 
 // DequeueDeleteNotCountedAsDrops returns if device dequeues and deletes the pkts after a while and those are not counted
 // as drops
 func DequeueDeleteNotCountedAsDrops(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDequeueDeleteNotCountedAsDrops()
+	return recorded(dut.ID(), "dequeue_delete_not_counted_as_drops", lookupDUTDeviations(dut).GetDequeueDeleteNotCountedAsDrops())
 }
 
 // RoutePolicyUnderAFIUnsupported returns if Route-Policy under the AFI/SAFI is not supported
 func RoutePolicyUnderAFIUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetRoutePolicyUnderAfiUnsupported()
+	return recorded(dut.ID(), "route_policy_under_afi_unsupported", lookupDUTDeviations(dut).GetRoutePolicyUnderAfiUnsupported())
 }
 
 // StorageComponentUnsupported returns if telemetry path /components/component/storage is not supported.
 func StorageComponentUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStorageComponentUnsupported()
+	return recorded(dut.ID(), "storage_component_unsupported", lookupDUTDeviations(dut).GetStorageComponentUnsupported())
 }
 
 // GNOIFabricComponentRebootUnsupported returns if device does not support use using gNOI to reboot the Fabric Component.
 func GNOIFabricComponentRebootUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGnoiFabricComponentRebootUnsupported()
+	return recorded(dut.ID(), "gnoi_fabric_component_reboot_unsupported", lookupDUTDeviations(dut).GetGnoiFabricComponentRebootUnsupported())
 }
 
 // SkipControllerCardPowerAdmin returns if power-admin-state config on controller card should be skipped.
 // Default value is false.
 func SkipControllerCardPowerAdmin(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipControllerCardPowerAdmin()
+	return recorded(dut.ID(), "skip_controller_card_power_admin", lookupDUTDeviations(dut).GetSkipControllerCardPowerAdmin())
 }
 
 // QOSOctets returns if device should skip checking QOS octet stats for interface.
 func QOSOctets(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosOctets()
+	return recorded(dut.ID(), "qos_octets", lookupDUTDeviations(dut).GetQosOctets())
 }
 
 // ISISInterfaceAfiUnsupported returns true for devices that don't support configuring
 // ISIS /afi-safi/af/config container.
 func ISISInterfaceAfiUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisInterfaceAfiUnsupported()
+	return recorded(dut.ID(), "isis_interface_afi_unsupported", lookupDUTDeviations(dut).GetIsisInterfaceAfiUnsupported())
 }
 
 // P4RTModifyTableEntryUnsupported returns true for devices that don't support
 // modify table entry operation in P4 Runtime.
 func P4RTModifyTableEntryUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetP4RtModifyTableEntryUnsupported()
+	return recorded(dut.ID(), "p4rt_modify_table_entry_unsupported", lookupDUTDeviations(dut).GetP4RtModifyTableEntryUnsupported())
 }
 
 // OSComponentParentIsSupervisorOrLinecard returns true if parent of OS component is
 // of type SUPERVISOR or LINECARD.
 func OSComponentParentIsSupervisorOrLinecard(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOsComponentParentIsSupervisorOrLinecard()
+	return recorded(dut.ID(), "os_component_parent_is_supervisor_or_linecard", lookupDUTDeviations(dut).GetOsComponentParentIsSupervisorOrLinecard())
 }
 
 // OSComponentParentIsChassis returns true if parent of OS component is of type CHASSIS.
 func OSComponentParentIsChassis(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOsComponentParentIsChassis()
+	return recorded(dut.ID(), "os_component_parent_is_chassis", lookupDUTDeviations(dut).GetOsComponentParentIsChassis())
 }
 
 // ISISRequireSameL1MetricWithL2Metric returns true for devices that require configuring
 // the same ISIS Metrics for Level 1 when configuring Level 2 Metrics.
 func ISISRequireSameL1MetricWithL2Metric(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisRequireSameL1MetricWithL2Metric()
+	return recorded(dut.ID(), "isis_require_same_l1_metric_with_l2_metric", lookupDUTDeviations(dut).GetIsisRequireSameL1MetricWithL2Metric())
 }
 
 // BGPSetMedRequiresEqualOspfSetMetric returns true for devices that require configuring
 // the same OSPF setMetric when BGP SetMED is configured.
 func BGPSetMedRequiresEqualOspfSetMetric(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpSetMedRequiresEqualOspfSetMetric()
+	return recorded(dut.ID(), "bgp_set_med_requires_equal_ospf_set_metric", lookupDUTDeviations(dut).GetBgpSetMedRequiresEqualOspfSetMetric())
 }
 
 // SetNativeUser creates a user and assigns role/rbac to that user via native model.
 func SetNativeUser(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSetNativeUser()
+	return recorded(dut.ID(), "set_native_user", lookupDUTDeviations(dut).GetSetNativeUser())
 }
 
 // P4RTGdpRequiresDot1QSubinterface returns true for devices that require configuring
 // subinterface with tagged vlan for P4RT packet in.
 func P4RTGdpRequiresDot1QSubinterface(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetP4RtGdpRequiresDot1QSubinterface()
+	return recorded(dut.ID(), "p4rt_gdp_requires_dot1q_subinterface", lookupDUTDeviations(dut).GetP4RtGdpRequiresDot1QSubinterface())
 }
 
 // LinecardCPUUtilizationUnsupported returns if the device does not support telemetry path
 // /components/component/cpu/utilization/state/avg for linecards' CPU card.
 // Default value is false.
 func LinecardCPUUtilizationUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLinecardCpuUtilizationUnsupported()
+	return recorded(dut.ID(), "linecard_cpu_utilization_unsupported", lookupDUTDeviations(dut).GetLinecardCpuUtilizationUnsupported())
 }
 
 // ConsistentComponentNamesUnsupported returns if the device does not support consistent component names for GNOI and GNMI.
 // Default value is false.
 func ConsistentComponentNamesUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetConsistentComponentNamesUnsupported()
+	return recorded(dut.ID(), "consistent_component_names_unsupported", lookupDUTDeviations(dut).GetConsistentComponentNamesUnsupported())
 }
 
 // ControllerCardCPUUtilizationUnsupported returns if the device does not support telemetry path
 // /components/component/cpu/utilization/state/avg for controller cards' CPU card.
 // Default value is false.
 func ControllerCardCPUUtilizationUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetControllerCardCpuUtilizationUnsupported()
+	return recorded(dut.ID(), "controller_card_cpu_utilization_unsupported", lookupDUTDeviations(dut).GetControllerCardCpuUtilizationUnsupported())
 }
 
 // FabricDropCounterUnsupported returns if the device does not support counter for fabric block lost packets.
 // Default value is false.
 func FabricDropCounterUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetFabricDropCounterUnsupported()
+	return recorded(dut.ID(), "fabric_drop_counter_unsupported", lookupDUTDeviations(dut).GetFabricDropCounterUnsupported())
 }
 
 // LinecardMemoryUtilizationUnsupported returns if the device does not support memory utilization related leaves for linecard components.
 // Default value is false.
 func LinecardMemoryUtilizationUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLinecardMemoryUtilizationUnsupported()
+	return recorded(dut.ID(), "linecard_memory_utilization_unsupported", lookupDUTDeviations(dut).GetLinecardMemoryUtilizationUnsupported())
 }
 
 // QOSVoqDropCounterUnsupported returns if the device does not support telemetry path
 // /qos/interfaces/interface/input/virtual-output-queues/voq-interface/queues/queue/state/dropped-pkts.
 // Default value is false.
 func QOSVoqDropCounterUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosVoqDropCounterUnsupported()
+	return recorded(dut.ID(), "qos_voq_drop_counter_unsupported", lookupDUTDeviations(dut).GetQosVoqDropCounterUnsupported())
 }
 
 // ISISTimersCsnpIntervalUnsupported returns true for devices that do not support
 // configuring csnp-interval timer for ISIS.
 func ISISTimersCsnpIntervalUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisTimersCsnpIntervalUnsupported()
+	return recorded(dut.ID(), "isis_timers_csnp_interval_unsupported", lookupDUTDeviations(dut).GetIsisTimersCsnpIntervalUnsupported())
 }
 
 // ISISCounterManualAddressDropFromAreasUnsupported returns true for devices that do not
 // support telemetry for isis system-level-counter manual-address-drop-from-areas.
 func ISISCounterManualAddressDropFromAreasUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisCounterManualAddressDropFromAreasUnsupported()
+	return recorded(dut.ID(), "isis_counter_manual_address_drop_from_areas_unsupported", lookupDUTDeviations(dut).GetIsisCounterManualAddressDropFromAreasUnsupported())
 }
 
 // ISISCounterPartChangesUnsupported returns true for devices that do not
 // support telemetry for isis system-level-counter part-changes.
 func ISISCounterPartChangesUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisCounterPartChangesUnsupported()
+	return recorded(dut.ID(), "isis_counter_part_changes_unsupported", lookupDUTDeviations(dut).GetIsisCounterPartChangesUnsupported())
 }
 
 // SkipTCPNegotiatedMSSCheck returns true for devices that do not
 // support telemetry to check negotiated tcp mss value.
 func SkipTCPNegotiatedMSSCheck(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipTcpNegotiatedMssCheck()
+	return recorded(dut.ID(), "skip_tcp_negotiated_mss_check", lookupDUTDeviations(dut).GetSkipTcpNegotiatedMssCheck())
 }
 
 // TransceiverThresholdsUnsupported returns true if the device does not support threshold container under /components/component/transceiver.
 // Default value is false.
 func TransceiverThresholdsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTransceiverThresholdsUnsupported()
+	return recorded(dut.ID(), "transceiver_thresholds_unsupported", lookupDUTDeviations(dut).GetTransceiverThresholdsUnsupported())
 }
 
 // InterfaceLoopbackModeRawGnmi returns true if interface loopback mode needs to be updated using raw gnmi API due to server version.
 // Default value is false.
 func InterfaceLoopbackModeRawGnmi(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceLoopbackModeRawGnmi()
+	return recorded(dut.ID(), "interface_loopback_mode_raw_gnmi", lookupDUTDeviations(dut).GetInterfaceLoopbackModeRawGnmi())
 }
 
 // ISISLspMetadataLeafsUnsupported returns true for devices that don't support ISIS-Lsp
 // metadata paths: checksum, sequence-number, remaining-lifetime.
 func ISISLspMetadataLeafsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisLspMetadataLeafsUnsupported()
+	return recorded(dut.ID(), "isis_lsp_metadata_leafs_unsupported", lookupDUTDeviations(dut).GetIsisLspMetadataLeafsUnsupported())
 }
 
 // QOSQueueRequiresID returns if device should configure QOS queue along with queue-id
 func QOSQueueRequiresID(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosQueueRequiresId()
+	return recorded(dut.ID(), "qos_queue_requires_id", lookupDUTDeviations(dut).GetQosQueueRequiresId())
 }
 
 // BgpLlgrOcUndefined returns true if device does not support OC path to disable BGP LLGR.
 func BgpLlgrOcUndefined(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpLlgrOcUndefined()
+	return recorded(dut.ID(), "bgp_llgr_oc_undefined", lookupDUTDeviations(dut).GetBgpLlgrOcUndefined())
 }
 
 // QOSBufferAllocationConfigRequired returns if device should configure QOS buffer-allocation-profile
 func QOSBufferAllocationConfigRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosBufferAllocationConfigRequired()
+	return recorded(dut.ID(), "qos_buffer_allocation_config_required", lookupDUTDeviations(dut).GetQosBufferAllocationConfigRequired())
 }
 
 // BGPGlobalExtendedNextHopEncodingUnsupported returns true for devices that do not support configuring
 // BGP ExtendedNextHopEncoding at the global level.
 func BGPGlobalExtendedNextHopEncodingUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpGlobalExtendedNextHopEncodingUnsupported()
+	return recorded(dut.ID(), "bgp_global_extended_next_hop_encoding_unsupported", lookupDUTDeviations(dut).GetBgpGlobalExtendedNextHopEncodingUnsupported())
 }
 
 // TunnelStatePathUnsupported returns true for devices that require configuring
 // /interfaces/interface/state/counters/in-pkts, in-octets,out-pkts, out-octetsis not supported.
 func TunnelStatePathUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTunnelStatePathUnsupported()
+	return recorded(dut.ID(), "tunnel_state_path_unsupported", lookupDUTDeviations(dut).GetTunnelStatePathUnsupported())
 }
 
 // TunnelConfigPathUnsupported returns true for devices that require configuring
 // Tunnel source-address destination-address, encapsulation type are not supported in OC
 func TunnelConfigPathUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTunnelConfigPathUnsupported()
+	return recorded(dut.ID(), "tunnel_config_path_unsupported", lookupDUTDeviations(dut).GetTunnelConfigPathUnsupported())
 }
 
 // EcnSameMinMaxThresholdUnsupported returns true for devices that don't support the same minimum and maximum threshold values
 // CISCO: minimum and maximum threshold values are not the same, the difference between minimum and maximum threshold value should be 6144.
 func EcnSameMinMaxThresholdUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetEcnSameMinMaxThresholdUnsupported()
+	return recorded(dut.ID(), "ecn_same_min_max_threshold_unsupported", lookupDUTDeviations(dut).GetEcnSameMinMaxThresholdUnsupported())
 }
 
 // QosSchedulerConfigRequired returns if device should configure QOS buffer-allocation-profile
 func QosSchedulerConfigRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosSchedulerConfigRequired()
+	return recorded(dut.ID(), "qos_scheduler_config_required", lookupDUTDeviations(dut).GetQosSchedulerConfigRequired())
 }
 
 // QosSetWeightConfigUnsupported returns whether the device does not support set weight leaves under qos ecn.
 func QosSetWeightConfigUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosSetWeightConfigUnsupported()
+	return recorded(dut.ID(), "qos_set_weight_config_unsupported", lookupDUTDeviations(dut).GetQosSetWeightConfigUnsupported())
 }
 
 // QosGetStatePathUnsupported returns whether the device does not support get state leaves under qos.
 func QosGetStatePathUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosGetStatePathUnsupported()
+	return recorded(dut.ID(), "qos_get_state_path_unsupported", lookupDUTDeviations(dut).GetQosGetStatePathUnsupported())
 }
 
 // InterfaceRefInterfaceIDFormat returns if device is required to use interface-id format of interface name + .subinterface index with Interface-ref container
 func InterfaceRefInterfaceIDFormat(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceRefInterfaceIdFormat()
+	return recorded(dut.ID(), "interface_ref_interface_id_format", lookupDUTDeviations(dut).GetInterfaceRefInterfaceIdFormat())
 }
 
 // ISISLevelEnabled returns if device should enable isis under level.
 func ISISLevelEnabled(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisLevelEnabled()
+	return recorded(dut.ID(), "isis_level_enabled", lookupDUTDeviations(dut).GetIsisLevelEnabled())
 }
 
 // MemberLinkLoopbackUnsupported returns true for devices that require configuring
 // loopback on aggregated links instead of member links.
 func MemberLinkLoopbackUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMemberLinkLoopbackUnsupported()
+	return recorded(dut.ID(), "member_link_loopback_unsupported", lookupDUTDeviations(dut).GetMemberLinkLoopbackUnsupported())
 }
 
 // SkipPlqInterfaceOperStatusCheck returns true for devices that do not support
 // PLQ operational status check for interfaces
 func SkipPlqInterfaceOperStatusCheck(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipPlqInterfaceOperStatusCheck()
+	return recorded(dut.ID(), "skip_plq_interface_oper_status_check", lookupDUTDeviations(dut).GetSkipPlqInterfaceOperStatusCheck())
 }
 
 // BGPExplicitPrefixLimitReceived returns if device must specify the received prefix limits explicitly
 // under the "prefix-limit-received" field rather than simply "prefix-limit".
 func BGPExplicitPrefixLimitReceived(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpExplicitPrefixLimitReceived()
+	return recorded(dut.ID(), "bgp_explicit_prefix_limit_received", lookupDUTDeviations(dut).GetBgpExplicitPrefixLimitReceived())
 }
 
 // BGPMissingOCMaxPrefixesConfiguration returns true for devices that does not configure BGP
 // maximum routes correctly when max-prefixes OC leaf is configured.
 func BGPMissingOCMaxPrefixesConfiguration(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpMissingOcMaxPrefixesConfiguration()
+	return recorded(dut.ID(), "bgp_missing_oc_max_prefixes_configuration", lookupDUTDeviations(dut).GetBgpMissingOcMaxPrefixesConfiguration())
 }
 
 // SkipBgpSessionCheckWithoutAfisafi returns if device needs to skip checking AFI-SAFI disable.
 func SkipBgpSessionCheckWithoutAfisafi(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipBgpSessionCheckWithoutAfisafi()
+	return recorded(dut.ID(), "skip_bgp_session_check_without_afisafi", lookupDUTDeviations(dut).GetSkipBgpSessionCheckWithoutAfisafi())
 }
 
 // MismatchedHardwareResourceNameInComponent returns true for devices that have separate
 // naming conventions for hardware resource name in /system/ tree and /components/ tree.
 func MismatchedHardwareResourceNameInComponent(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMismatchedHardwareResourceNameInComponent()
+	return recorded(dut.ID(), "mismatched_hardware_resource_name_in_component", lookupDUTDeviations(dut).GetMismatchedHardwareResourceNameInComponent())
 }
 
 // GNOISubcomponentRebootStatusUnsupported returns true for devices that do not support subcomponent reboot status check.
 func GNOISubcomponentRebootStatusUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGnoiSubcomponentRebootStatusUnsupported()
+	return recorded(dut.ID(), "gnoi_subcomponent_reboot_status_unsupported", lookupDUTDeviations(dut).GetGnoiSubcomponentRebootStatusUnsupported())
 }
 
 // SkipNonBgpRouteExportCheck returns true for devices that exports routes from all
 // protocols to BGP if the export-policy is ACCEPT.
 func SkipNonBgpRouteExportCheck(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipNonBgpRouteExportCheck()
+	return recorded(dut.ID(), "skip_non_bgp_route_export_check", lookupDUTDeviations(dut).GetSkipNonBgpRouteExportCheck())
 }
 
 // ISISMetricStyleTelemetryUnsupported returns true for devices that do not support state path
 // /network-instances/network-instance/protocols/protocol/isis/levels/level/state/metric-style
 func ISISMetricStyleTelemetryUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisMetricStyleTelemetryUnsupported()
+	return recorded(dut.ID(), "isis_metric_style_telemetry_unsupported", lookupDUTDeviations(dut).GetIsisMetricStyleTelemetryUnsupported())
 }
 
 // StaticRouteNextHopInterfaceRefUnsupported returns if device does not support Interface-ref under static-route next-hop
 func StaticRouteNextHopInterfaceRefUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStaticRouteNextHopInterfaceRefUnsupported()
+	return recorded(dut.ID(), "static_route_next_hop_interface_ref_unsupported", lookupDUTDeviations(dut).GetStaticRouteNextHopInterfaceRefUnsupported())
 }
 
 // SkipStaticNexthopCheck returns if device needs index starting from non-zero
 func SkipStaticNexthopCheck(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipStaticNexthopCheck()
+	return recorded(dut.ID(), "skip_static_nexthop_check", lookupDUTDeviations(dut).GetSkipStaticNexthopCheck())
 }
 
 // Ipv6RouterAdvertisementConfigUnsupported returns true for devices which don't support Ipv6 RouterAdvertisement configuration
 func Ipv6RouterAdvertisementConfigUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIpv6RouterAdvertisementConfigUnsupported()
+	return recorded(dut.ID(), "ipv6_router_advertisement_config_unsupported", lookupDUTDeviations(dut).GetIpv6RouterAdvertisementConfigUnsupported())
 }
 
 // PrefixLimitExceededTelemetryUnsupported is to skip checking prefix limit telemetry flag.
 func PrefixLimitExceededTelemetryUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPrefixLimitExceededTelemetryUnsupported()
+	return recorded(dut.ID(), "prefix_limit_exceeded_telemetry_unsupported", lookupDUTDeviations(dut).GetPrefixLimitExceededTelemetryUnsupported())
 }
 
 // SkipSettingAllowMultipleAS return true if device needs to skip setting allow-multiple-as while configuring eBGP
 func SkipSettingAllowMultipleAS(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipSettingAllowMultipleAs()
+	return recorded(dut.ID(), "skip_setting_allow_multiple_as", lookupDUTDeviations(dut).GetSkipSettingAllowMultipleAs())
 }
 
 // GribiDecapMixedPlenUnsupported returns true if devices does not support
 // programming with mixed prefix length.
 func GribiDecapMixedPlenUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGribiDecapMixedPlenUnsupported()
+	return recorded(dut.ID(), "gribi_decap_mixed_plen_unsupported", lookupDUTDeviations(dut).GetGribiDecapMixedPlenUnsupported())
 }
 
 // SkipIsisSetLevel return true if device needs to skip setting isis-actions set-level while configuring routing-policy statement action
 func SkipIsisSetLevel(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipIsisSetLevel()
+	return recorded(dut.ID(), "skip_isis_set_level", lookupDUTDeviations(dut).GetSkipIsisSetLevel())
 }
 
 // SkipIsisSetMetricStyleType return true if device needs to skip setting isis-actions set-metric-style-type while configuring routing-policy statement action
 func SkipIsisSetMetricStyleType(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipIsisSetMetricStyleType()
+	return recorded(dut.ID(), "skip_isis_set_metric_style_type", lookupDUTDeviations(dut).GetSkipIsisSetMetricStyleType())
 }
 
 // SkipSettingDisableMetricPropagation return true if device needs to skip setting disable-metric-propagation while configuring table-connection
 func SkipSettingDisableMetricPropagation(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipSettingDisableMetricPropagation()
+	return recorded(dut.ID(), "skip_setting_disable_metric_propagation", lookupDUTDeviations(dut).GetSkipSettingDisableMetricPropagation())
 }
 
 // BGPConditionsMatchCommunitySetUnsupported returns true if device doesn't support bgp-conditions/match-community-set leaf
 func BGPConditionsMatchCommunitySetUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpConditionsMatchCommunitySetUnsupported()
+	return recorded(dut.ID(), "bgp_conditions_match_community_set_unsupported", lookupDUTDeviations(dut).GetBgpConditionsMatchCommunitySetUnsupported())
 }
 
 // PfRequireMatchDefaultRule returns true for device which requires match condition for ether type v4 and v6 for default rule with network-instance default-vrf in policy-forwarding.
 func PfRequireMatchDefaultRule(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPfRequireMatchDefaultRule()
+	return recorded(dut.ID(), "pf_require_match_default_rule", lookupDUTDeviations(dut).GetPfRequireMatchDefaultRule())
 }
 
 // MissingPortToOpticalChannelMapping returns true for devices missing component tree mapping from hardware port to optical channel.
 func MissingPortToOpticalChannelMapping(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMissingPortToOpticalChannelComponentMapping()
+	return recorded(dut.ID(), "missing_port_to_optical_channel_component_mapping", lookupDUTDeviations(dut).GetMissingPortToOpticalChannelComponentMapping())
 }
 
 // SkipContainerOp returns true if gNMI container OP needs to be skipped.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/322291556
 func SkipContainerOp(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipContainerOp()
+	return recorded(dut.ID(), "skip_container_op", lookupDUTDeviations(dut).GetSkipContainerOp())
 }
 
 // ReorderCallsForVendorCompatibilty returns true if call needs to be updated/added/deleted.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/322291556
 func ReorderCallsForVendorCompatibilty(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetReorderCallsForVendorCompatibilty()
+	return recorded(dut.ID(), "reorder_calls_for_vendor_compatibilty", lookupDUTDeviations(dut).GetReorderCallsForVendorCompatibilty())
 }
 
 // AddMissingBaseConfigViaCli returns true if missing base config needs to be added using CLI.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/322291556
 func AddMissingBaseConfigViaCli(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAddMissingBaseConfigViaCli()
+	return recorded(dut.ID(), "add_missing_base_config_via_cli", lookupDUTDeviations(dut).GetAddMissingBaseConfigViaCli())
 }
 
 // SkipMacaddressCheck returns true if mac address for an interface via gNMI needs to be skipped.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/322291556
 func SkipMacaddressCheck(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipMacaddressCheck()
+	return recorded(dut.ID(), "skip_macaddress_check", lookupDUTDeviations(dut).GetSkipMacaddressCheck())
 }
 
 // BGPRibOcPathUnsupported returns true if BGP RIB OC telemetry path is not supported.
 func BGPRibOcPathUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpRibOcPathUnsupported()
+	return recorded(dut.ID(), "bgp_rib_oc_path_unsupported", lookupDUTDeviations(dut).GetBgpRibOcPathUnsupported())
 }
 
 // SkipPrefixSetMode return true if device needs to skip setting prefix-set mode while configuring prefix-set routing-policy
 func SkipPrefixSetMode(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipPrefixSetMode()
+	return recorded(dut.ID(), "skip_prefix_set_mode", lookupDUTDeviations(dut).GetSkipPrefixSetMode())
 }
 
 // SetMetricAsPreference returns true for devices which set metric as
 // preference for static next-hop
 func SetMetricAsPreference(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSetMetricAsPreference()
+	return recorded(dut.ID(), "set_metric_as_preference", lookupDUTDeviations(dut).GetSetMetricAsPreference())
 }
 
 // IPv6StaticRouteWithIPv4NextHopRequiresStaticARP returns true if devices don't support having an
 // IPv6 static Route with an IPv4 address as next hop and requires configuring a static ARP entry.
 // Arista: https://partnerissuetracker.corp.google.com/issues/316593298
 func IPv6StaticRouteWithIPv4NextHopRequiresStaticARP(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIpv6StaticRouteWithIpv4NextHopRequiresStaticArp()
+	return recorded(dut.ID(), "ipv6_static_route_with_ipv4_next_hop_requires_static_arp", lookupDUTDeviations(dut).GetIpv6StaticRouteWithIpv4NextHopRequiresStaticArp())
 }
 
 // PfRequireSequentialOrderPbrRules returns true for device requires policy-forwarding rules to be in sequential order in the gNMI set-request.
 func PfRequireSequentialOrderPbrRules(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPfRequireSequentialOrderPbrRules()
+	return recorded(dut.ID(), "pf_require_sequential_order_pbr_rules", lookupDUTDeviations(dut).GetPfRequireSequentialOrderPbrRules())
 }
 
 // MissingStaticRouteNextHopMetricTelemetry returns true for devices missing
 // static route next-hop metric telemetry.
 // Arista: https://partnerissuetracker.corp.google.com/issues/321010782
 func MissingStaticRouteNextHopMetricTelemetry(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMissingStaticRouteNextHopMetricTelemetry()
+	return recorded(dut.ID(), "missing_static_route_next_hop_metric_telemetry", lookupDUTDeviations(dut).GetMissingStaticRouteNextHopMetricTelemetry())
 }
 
 // UnsupportedStaticRouteNextHopRecurse returns true for devices that don't support recursive
 // resolution of static route next hop.
 // Arista: https://partnerissuetracker.corp.google.com/issues/314449182
 func UnsupportedStaticRouteNextHopRecurse(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetUnsupportedStaticRouteNextHopRecurse()
+	return recorded(dut.ID(), "unsupported_static_route_next_hop_recurse", lookupDUTDeviations(dut).GetUnsupportedStaticRouteNextHopRecurse())
 }
 
 // MissingStaticRouteDropNextHopTelemetry returns true for devices missing
 // static route telemetry with DROP next hop.
 // Arista: https://partnerissuetracker.corp.google.com/issues/330619816
 func MissingStaticRouteDropNextHopTelemetry(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMissingStaticRouteDropNextHopTelemetry()
+	return recorded(dut.ID(), "missing_static_route_drop_next_hop_telemetry", lookupDUTDeviations(dut).GetMissingStaticRouteDropNextHopTelemetry())
 }
 
 // MissingZROpticalChannelTunableParametersTelemetry returns true for devices missing 400ZR
 // optical-channel tunable parameters telemetry: min/max/avg.
 // Arista: https://partnerissuetracker.corp.google.com/issues/319314781
 func MissingZROpticalChannelTunableParametersTelemetry(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMissingZrOpticalChannelTunableParametersTelemetry()
+	return recorded(dut.ID(), "missing_zr_optical_channel_tunable_parameters_telemetry", lookupDUTDeviations(dut).GetMissingZrOpticalChannelTunableParametersTelemetry())
 }
 
 // PLQReflectorStatsUnsupported returns true for devices that does not support packet link qualification(PLQ) reflector packet sent/received stats.
 func PLQReflectorStatsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPlqReflectorStatsUnsupported()
+	return recorded(dut.ID(), "plq_reflector_stats_unsupported", lookupDUTDeviations(dut).GetPlqReflectorStatsUnsupported())
 }
 
 // PLQGeneratorCapabilitiesMaxMTU returns supported max_mtu for devices that does not support packet link qualification(PLQ) Generator max_mtu to be at least >= 8184.
 func PLQGeneratorCapabilitiesMaxMTU(dut *ondatra.DUTDevice) uint32 {
-	return lookupDUTDeviations(dut).GetPlqGeneratorCapabilitiesMaxMtu()
+	return recorded(dut.ID(), "plq_generator_capabilities_max_mtu", lookupDUTDeviations(dut).GetPlqGeneratorCapabilitiesMaxMtu())
 }
 
 // PLQGeneratorCapabilitiesMaxPPS returns supported max_pps for devices that does not support packet link qualification(PLQ) Generator max_pps to be at least >= 100000000.
 func PLQGeneratorCapabilitiesMaxPPS(dut *ondatra.DUTDevice) uint64 {
-	return lookupDUTDeviations(dut).GetPlqGeneratorCapabilitiesMaxPps()
+	return recorded(dut.ID(), "plq_generator_capabilities_max_pps", lookupDUTDeviations(dut).GetPlqGeneratorCapabilitiesMaxPps())
 }
 
 // BgpExtendedCommunityIndexUnsupported return true if BGP extended community index is not supported.
 func BgpExtendedCommunityIndexUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpExtendedCommunityIndexUnsupported()
+	return recorded(dut.ID(), "bgp_extended_community_index_unsupported", lookupDUTDeviations(dut).GetBgpExtendedCommunityIndexUnsupported())
 }
 
 // BgpCommunitySetRefsUnsupported return true if BGP community set refs is not supported.
 func BgpCommunitySetRefsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpCommunitySetRefsUnsupported()
+	return recorded(dut.ID(), "bgp_community_set_refs_unsupported", lookupDUTDeviations(dut).GetBgpCommunitySetRefsUnsupported())
 }
 
 // TableConnectionsUnsupported returns true if Table Connections are unsupported.
 func TableConnectionsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTableConnectionsUnsupported()
+	return recorded(dut.ID(), "table_connections_unsupported", lookupDUTDeviations(dut).GetTableConnectionsUnsupported())
 }
 
 // UseVendorNativeTagSetConfig returns whether a device requires native model to configure tag-set
 func UseVendorNativeTagSetConfig(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetUseVendorNativeTagSetConfig()
+	return recorded(dut.ID(), "use_vendor_native_tag_set_config", lookupDUTDeviations(dut).GetUseVendorNativeTagSetConfig())
 }
 
 // SkipBgpSendCommunityType return true if device needs to skip setting BGP send-community-type
 func SkipBgpSendCommunityType(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipBgpSendCommunityType()
+	return recorded(dut.ID(), "skip_bgp_send_community_type", lookupDUTDeviations(dut).GetSkipBgpSendCommunityType())
 }
 
 // BgpActionsSetCommunityMethodUnsupported return true if BGP actions set-community method is unsupported
 func BgpActionsSetCommunityMethodUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpActionsSetCommunityMethodUnsupported()
+	return recorded(dut.ID(), "bgp_actions_set_community_method_unsupported", lookupDUTDeviations(dut).GetBgpActionsSetCommunityMethodUnsupported())
 
 }
 
 // SetNoPeerGroup Ensure that no BGP configurations exists under PeerGroups.
 func SetNoPeerGroup(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSetNoPeerGroup()
+	return recorded(dut.ID(), "set_no_peer_group", lookupDUTDeviations(dut).GetSetNoPeerGroup())
 }
 
 // BgpCommunityMemberIsAString returns true if device community member is not a list
 func BgpCommunityMemberIsAString(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpCommunityMemberIsAString()
+	return recorded(dut.ID(), "bgp_community_member_is_a_string", lookupDUTDeviations(dut).GetBgpCommunityMemberIsAString())
 }
 
 // IPv4StaticRouteWithIPv6NextHopUnsupported unsupported ipv4 with ipv6 nexthop
 func IPv4StaticRouteWithIPv6NextHopUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIpv4StaticRouteWithIpv6NhUnsupported()
+	return recorded(dut.ID(), "ipv4_static_route_with_ipv6_nh_unsupported", lookupDUTDeviations(dut).GetIpv4StaticRouteWithIpv6NhUnsupported())
 }
 
 // IPv6StaticRouteWithIPv4NextHopUnsupported unsupported ipv6 with ipv4 nexthop
 func IPv6StaticRouteWithIPv4NextHopUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIpv6StaticRouteWithIpv4NhUnsupported()
+	return recorded(dut.ID(), "ipv6_static_route_with_ipv4_nh_unsupported", lookupDUTDeviations(dut).GetIpv6StaticRouteWithIpv4NhUnsupported())
 }
 
 // StaticRouteWithDropNhUnsupported unsupported drop nexthop
 func StaticRouteWithDropNhUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStaticRouteWithDropNh()
+	return recorded(dut.ID(), "static_route_with_drop_nh", lookupDUTDeviations(dut).GetStaticRouteWithDropNh())
 }
 
 // StaticRouteWithExplicitMetric set explicit metric
 func StaticRouteWithExplicitMetric(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStaticRouteWithExplicitMetric()
+	return recorded(dut.ID(), "static_route_with_explicit_metric", lookupDUTDeviations(dut).GetStaticRouteWithExplicitMetric())
 }
 
 // BgpDefaultPolicyUnsupported return true if BGP default-import/export-policy is not supported.
 func BgpDefaultPolicyUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpDefaultPolicyUnsupported()
+	return recorded(dut.ID(), "bgp_default_policy_unsupported", lookupDUTDeviations(dut).GetBgpDefaultPolicyUnsupported())
 }
 
 // ExplicitEnableBGPOnDefaultVRF return true if BGP needs to be explicitly enabled on default VRF
 func ExplicitEnableBGPOnDefaultVRF(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetExplicitEnableBgpOnDefaultVrf()
+	return recorded(dut.ID(), "explicit_enable_bgp_on_default_vrf", lookupDUTDeviations(dut).GetExplicitEnableBgpOnDefaultVrf())
 }
 
 // RoutingPolicyTagSetEmbedded returns true if the implementation does not support tag-set(s) as a
 // separate entity, but embeds it in the policy statement
 func RoutingPolicyTagSetEmbedded(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetRoutingPolicyTagSetEmbedded()
+	return recorded(dut.ID(), "routing_policy_tag_set_embedded", lookupDUTDeviations(dut).GetRoutingPolicyTagSetEmbedded())
 }
 
 // SkipAfiSafiPathForBgpMultipleAs return true if device do not support afi/safi path to enable allow multiple-as for eBGP
 func SkipAfiSafiPathForBgpMultipleAs(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipAfiSafiPathForBgpMultipleAs()
+	return recorded(dut.ID(), "skip_afi_safi_path_for_bgp_multiple_as", lookupDUTDeviations(dut).GetSkipAfiSafiPathForBgpMultipleAs())
 }
 
 // CommunityMemberRegexUnsupported return true if device do not support community member regex
 func CommunityMemberRegexUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetCommunityMemberRegexUnsupported()
+	return recorded(dut.ID(), "community_member_regex_unsupported", lookupDUTDeviations(dut).GetCommunityMemberRegexUnsupported())
 }
 
 // SamePolicyAttachedToAllAfis returns true if same import policy has to be applied for all AFIs
 func SamePolicyAttachedToAllAfis(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSamePolicyAttachedToAllAfis()
+	return recorded(dut.ID(), "same_policy_attached_to_all_afis", lookupDUTDeviations(dut).GetSamePolicyAttachedToAllAfis())
 }
 
 // SkipSettingStatementForPolicy return true if device do not support afi/safi path to enable allow multiple-as for eBGP
 func SkipSettingStatementForPolicy(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipSettingStatementForPolicy()
+	return recorded(dut.ID(), "skip_setting_statement_for_policy", lookupDUTDeviations(dut).GetSkipSettingStatementForPolicy())
 }
 
 // SkipCheckingAttributeIndex return true if device do not return bgp attribute for the bgp session specifying the index
 func SkipCheckingAttributeIndex(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipCheckingAttributeIndex()
+	return recorded(dut.ID(), "skip_checking_attribute_index", lookupDUTDeviations(dut).GetSkipCheckingAttributeIndex())
 }
 
 // FlattenPolicyWithMultipleStatements return true if devices does not support policy-chaining
 func FlattenPolicyWithMultipleStatements(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetFlattenPolicyWithMultipleStatements()
+	return recorded(dut.ID(), "flatten_policy_with_multiple_statements", lookupDUTDeviations(dut).GetFlattenPolicyWithMultipleStatements())
 }
 
 // SlaacPrefixLength128 for Slaac generated IPv6 link local address
 func SlaacPrefixLength128(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSlaacPrefixLength128()
+	return recorded(dut.ID(), "slaac_prefix_length128", lookupDUTDeviations(dut).GetSlaacPrefixLength128())
 }
 
 // DefaultRoutePolicyUnsupported returns true if default route policy is not supported
 func DefaultRoutePolicyUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDefaultRoutePolicyUnsupported()
+	return recorded(dut.ID(), "default_route_policy_unsupported", lookupDUTDeviations(dut).GetDefaultRoutePolicyUnsupported())
 }
 
 // CommunityMatchWithRedistributionUnsupported is set to true for devices that do not support matching community at the redistribution attach point.
 func CommunityMatchWithRedistributionUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetCommunityMatchWithRedistributionUnsupported()
+	return recorded(dut.ID(), "community_match_with_redistribution_unsupported", lookupDUTDeviations(dut).GetCommunityMatchWithRedistributionUnsupported())
 }
 
 // BgpMaxMultipathPathsUnsupported returns true if the device does not support
 // bgp max multipaths.
 func BgpMaxMultipathPathsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpMaxMultipathPathsUnsupported()
+	return recorded(dut.ID(), "bgp_max_multipath_paths_unsupported", lookupDUTDeviations(dut).GetBgpMaxMultipathPathsUnsupported())
 }
 
 // MultipathUnsupportedNeighborOrAfisafi returns true if the device does not
 // support multipath under neighbor or afisafi.
 func MultipathUnsupportedNeighborOrAfisafi(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMultipathUnsupportedNeighborOrAfisafi()
+	return recorded(dut.ID(), "multipath_unsupported_neighbor_or_afisafi", lookupDUTDeviations(dut).GetMultipathUnsupportedNeighborOrAfisafi())
 }
 
 // ModelNameUnsupported returns true if /components/components/state/model-name
 // is not supported for any component type.
 func ModelNameUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetModelNameUnsupported()
+	return recorded(dut.ID(), "model_name_unsupported", lookupDUTDeviations(dut).GetModelNameUnsupported())
 }
 
 // InstallPositionAndInstallComponentUnsupported returns true if install
 // position and install component are not supported.
 func InstallPositionAndInstallComponentUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInstallPositionAndInstallComponentUnsupported()
+	return recorded(dut.ID(), "install_position_and_install_component_unsupported", lookupDUTDeviations(dut).GetInstallPositionAndInstallComponentUnsupported())
 }
 
 // EncapTunnelShutBackupNhgZeroTraffic returns true when encap tunnel is shut then zero traffic flows to back-up NHG
 func EncapTunnelShutBackupNhgZeroTraffic(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetEncapTunnelShutBackupNhgZeroTraffic()
+	return recorded(dut.ID(), "encap_tunnel_shut_backup_nhg_zero_traffic", lookupDUTDeviations(dut).GetEncapTunnelShutBackupNhgZeroTraffic())
 }
 
 // MaxEcmpPaths supported for isis max ecmp path
 func MaxEcmpPaths(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMaxEcmpPaths()
+	return recorded(dut.ID(), "max_ecmp_paths", lookupDUTDeviations(dut).GetMaxEcmpPaths())
 }
 
 // WecmpAutoUnsupported returns true if wecmp auto is not supported
 func WecmpAutoUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetWecmpAutoUnsupported()
+	return recorded(dut.ID(), "wecmp_auto_unsupported", lookupDUTDeviations(dut).GetWecmpAutoUnsupported())
 }
 
 // RoutingPolicyChainingUnsupported returns true if policy chaining is unsupported
 func RoutingPolicyChainingUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetRoutingPolicyChainingUnsupported()
+	return recorded(dut.ID(), "routing_policy_chaining_unsupported", lookupDUTDeviations(dut).GetRoutingPolicyChainingUnsupported())
 }
 
 // ISISLoopbackRequired returns true if isis loopback is required.
 func ISISLoopbackRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisLoopbackRequired()
+	return recorded(dut.ID(), "isis_loopback_required", lookupDUTDeviations(dut).GetIsisLoopbackRequired())
 }
 
 // WeightedEcmpFixedPacketVerification returns true if fixed packet is used in traffic flow
 func WeightedEcmpFixedPacketVerification(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetWeightedEcmpFixedPacketVerification()
+	return recorded(dut.ID(), "weighted_ecmp_fixed_packet_verification", lookupDUTDeviations(dut).GetWeightedEcmpFixedPacketVerification())
 }
 
 // OverrideDefaultNhScale returns true if default NextHop scale needs to be modified
 // else returns false
 func OverrideDefaultNhScale(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOverrideDefaultNhScale()
+	return recorded(dut.ID(), "override_default_nh_scale", lookupDUTDeviations(dut).GetOverrideDefaultNhScale())
 }
 
 // BgpExtendedCommunitySetUnsupported returns true if set bgp extended community is unsupported
 func BgpExtendedCommunitySetUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpExtendedCommunitySetUnsupported()
+	return recorded(dut.ID(), "bgp_extended_community_set_unsupported", lookupDUTDeviations(dut).GetBgpExtendedCommunitySetUnsupported())
 }
 
 // BgpSetExtCommunitySetRefsUnsupported returns true if bgp set ext community refs is unsupported
 func BgpSetExtCommunitySetRefsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpSetExtCommunitySetRefsUnsupported()
+	return recorded(dut.ID(), "bgp_set_ext_community_set_refs_unsupported", lookupDUTDeviations(dut).GetBgpSetExtCommunitySetRefsUnsupported())
 }
 
 // BgpDeleteLinkBandwidthUnsupported returns true if bgp delete link bandwidth is unsupported
 func BgpDeleteLinkBandwidthUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpDeleteLinkBandwidthUnsupported()
+	return recorded(dut.ID(), "bgp_delete_link_bandwidth_unsupported", lookupDUTDeviations(dut).GetBgpDeleteLinkBandwidthUnsupported())
 }
 
 // QOSInQueueDropCounterUnsupported returns true if /qos/interfaces/interface/input/queues/queue/state/dropped-pkts
 // is not supported for any component type.
 func QOSInQueueDropCounterUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosInqueueDropCounterUnsupported()
+	return recorded(dut.ID(), "qos_inqueue_drop_counter_unsupported", lookupDUTDeviations(dut).GetQosInqueueDropCounterUnsupported())
 }
 
 // BgpExplicitExtendedCommunityEnable returns true if explicit extended community enable is needed
 func BgpExplicitExtendedCommunityEnable(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpExplicitExtendedCommunityEnable()
+	return recorded(dut.ID(), "bgp_explicit_extended_community_enable", lookupDUTDeviations(dut).GetBgpExplicitExtendedCommunityEnable())
 }
 
 // MatchTagSetConditionUnsupported returns true if match tag set condition is not supported
 func MatchTagSetConditionUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMatchTagSetConditionUnsupported()
+	return recorded(dut.ID(), "match_tag_set_condition_unsupported", lookupDUTDeviations(dut).GetMatchTagSetConditionUnsupported())
 }
 
 // PeerGroupDefEbgpVrfUnsupported returns true if peer group definition under ebgp vrf is unsupported
 func PeerGroupDefEbgpVrfUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPeerGroupDefEbgpVrfUnsupported()
+	return recorded(dut.ID(), "peer_group_def_ebgp_vrf_unsupported", lookupDUTDeviations(dut).GetPeerGroupDefEbgpVrfUnsupported())
 }
 
 // RedisConnectedUnderEbgpVrfUnsupported returns true if redistribution of routes under ebgp vrf is unsupported
 func RedisConnectedUnderEbgpVrfUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetRedisConnectedUnderEbgpVrfUnsupported()
+	return recorded(dut.ID(), "redis_connected_under_ebgp_vrf_unsupported", lookupDUTDeviations(dut).GetRedisConnectedUnderEbgpVrfUnsupported())
 }
 
 // BgpAfiSafiInDefaultNiBeforeOtherNi returns true if certain AFI SAFIs are configured in default network instance before other network instances
 func BgpAfiSafiInDefaultNiBeforeOtherNi(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpAfiSafiInDefaultNiBeforeOtherNi()
+	return recorded(dut.ID(), "bgp_afi_safi_in_default_ni_before_other_ni", lookupDUTDeviations(dut).GetBgpAfiSafiInDefaultNiBeforeOtherNi())
 }
 
 // DefaultImportExportPolicyUnsupported returns true when device
 // does not support default import export policy.
 func DefaultImportExportPolicyUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDefaultImportExportPolicyUnsupported()
+	return recorded(dut.ID(), "default_import_export_policy_unsupported", lookupDUTDeviations(dut).GetDefaultImportExportPolicyUnsupported())
 }
 
 // CommunityInvertAnyUnsupported returns true when device
 // does not support community invert any.
 func CommunityInvertAnyUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetCommunityInvertAnyUnsupported()
+	return recorded(dut.ID(), "community_invert_any_unsupported", lookupDUTDeviations(dut).GetCommunityInvertAnyUnsupported())
 }
 
 // Ipv6RouterAdvertisementIntervalUnsupported returns true for devices which don't support Ipv6 RouterAdvertisement interval configuration
 func Ipv6RouterAdvertisementIntervalUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIpv6RouterAdvertisementIntervalUnsupported()
+	return recorded(dut.ID(), "ipv6_router_advertisement_interval_unsupported", lookupDUTDeviations(dut).GetIpv6RouterAdvertisementIntervalUnsupported())
 }
 
 // DecapNHWithNextHopNIUnsupported returns true if Decap NH with NextHopNetworkInstance is unsupported
 // Arista: https://issuetracker.google.com/512135230
 func DecapNHWithNextHopNIUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDecapNhWithNexthopNiUnsupported()
+	return recorded(dut.ID(), "decap_nh_with_nexthop_ni_unsupported", lookupDUTDeviations(dut).GetDecapNhWithNexthopNiUnsupported())
 }
 
 // SflowSourceAddressUpdateUnsupported returns true if sflow source address update is unsupported
 func SflowSourceAddressUpdateUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSflowSourceAddressUpdateUnsupported()
+	return recorded(dut.ID(), "sflow_source_address_update_unsupported", lookupDUTDeviations(dut).GetSflowSourceAddressUpdateUnsupported())
 }
 
 // LinkLocalMaskLen returns true if linklocal mask length is not 64
 func LinkLocalMaskLen(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLinkLocalMaskLen()
+	return recorded(dut.ID(), "link_local_mask_len", lookupDUTDeviations(dut).GetLinkLocalMaskLen())
 }
 
 // UseParentComponentForTemperatureTelemetry returns true if parent component supports temperature telemetry
 func UseParentComponentForTemperatureTelemetry(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetUseParentComponentForTemperatureTelemetry()
+	return recorded(dut.ID(), "use_parent_component_for_temperature_telemetry", lookupDUTDeviations(dut).GetUseParentComponentForTemperatureTelemetry())
 }
 
 // ComponentMfgDateUnsupported returns true if component's mfg-date leaf is unsupported
 func ComponentMfgDateUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetComponentMfgDateUnsupported()
+	return recorded(dut.ID(), "component_mfg_date_unsupported", lookupDUTDeviations(dut).GetComponentMfgDateUnsupported())
 }
 
 // InterfaceCountersUpdateDelayed returns true if telemetry for interface counters
 // does not return the latest counter values.
 func InterfaceCountersUpdateDelayed(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceCountersUpdateDelayed()
+	return recorded(dut.ID(), "interface_counters_update_delayed", lookupDUTDeviations(dut).GetInterfaceCountersUpdateDelayed())
 }
 
 // OTNChannelTribUnsupported returns true if TRIB parameter is unsupported under OTN channel configuration
 func OTNChannelTribUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOtnChannelTribUnsupported()
+	return recorded(dut.ID(), "otn_channel_trib_unsupported", lookupDUTDeviations(dut).GetOtnChannelTribUnsupported())
 }
 
 // EthChannelIngressParametersUnsupported returns true if ingress parameters are unsupported under ETH channel configuration
 func EthChannelIngressParametersUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetEthChannelIngressParametersUnsupported()
+	return recorded(dut.ID(), "eth_channel_ingress_parameters_unsupported", lookupDUTDeviations(dut).GetEthChannelIngressParametersUnsupported())
 }
 
 // EthChannelAssignmentCiscoNumbering returns true if eth channel assignment index starts from 1 instead of 0
 func EthChannelAssignmentCiscoNumbering(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetEthChannelAssignmentCiscoNumbering()
+	return recorded(dut.ID(), "eth_channel_assignment_cisco_numbering", lookupDUTDeviations(dut).GetEthChannelAssignmentCiscoNumbering())
 }
 
 // ChassisGetRPCUnsupported returns true if a Healthz Get RPC against the Chassis component is unsupported
 func ChassisGetRPCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetChassisGetRpcUnsupported()
+	return recorded(dut.ID(), "chassis_get_rpc_unsupported", lookupDUTDeviations(dut).GetChassisGetRpcUnsupported())
 }
 
 // PowerDisableEnableLeafRefValidation returns true if definition of leaf-ref is not supported.
 func PowerDisableEnableLeafRefValidation(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPowerDisableEnableLeafRefValidation()
+	return recorded(dut.ID(), "power_disable_enable_leaf_ref_validation", lookupDUTDeviations(dut).GetPowerDisableEnableLeafRefValidation())
 }
 
 // SSHServerCountersUnsupported is to skip checking ssh server counters.
 func SSHServerCountersUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSshServerCountersUnsupported()
+	return recorded(dut.ID(), "ssh_server_counters_unsupported", lookupDUTDeviations(dut).GetSshServerCountersUnsupported())
 }
 
 // OperationalModeUnsupported returns true if operational-mode leaf is unsupported
 func OperationalModeUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOperationalModeUnsupported()
+	return recorded(dut.ID(), "operational_mode_unsupported", lookupDUTDeviations(dut).GetOperationalModeUnsupported())
 }
 
 // BgpSessionStateIdleInPassiveMode returns true if BGP session state idle is not supported instead of active in passive mode.
 func BgpSessionStateIdleInPassiveMode(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpSessionStateIdleInPassiveMode()
+	return recorded(dut.ID(), "bgp_session_state_idle_in_passive_mode", lookupDUTDeviations(dut).GetBgpSessionStateIdleInPassiveMode())
 }
 
 // EnableMultipathUnderAfiSafi returns true for devices that do not support multipath under /global path and instead support under global/afi/safi path.
 func EnableMultipathUnderAfiSafi(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetEnableMultipathUnderAfiSafi()
+	return recorded(dut.ID(), "enable_multipath_under_afi_safi", lookupDUTDeviations(dut).GetEnableMultipathUnderAfiSafi())
 }
 
 // OTNChannelAssignmentCiscoNumbering returns true if OTN channel assignment index starts from 1 instead of 0
 func OTNChannelAssignmentCiscoNumbering(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOtnChannelAssignmentCiscoNumbering()
+	return recorded(dut.ID(), "otn_channel_assignment_cisco_numbering", lookupDUTDeviations(dut).GetOtnChannelAssignmentCiscoNumbering())
 }
 
 // CiscoPreFECBERInactiveValue returns true if a non-zero pre-fec-ber value is to be used for Cisco
 func CiscoPreFECBERInactiveValue(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetCiscoPreFecBerInactiveValue()
+	return recorded(dut.ID(), "cisco_pre_fec_ber_inactive_value", lookupDUTDeviations(dut).GetCiscoPreFecBerInactiveValue())
 }
 
 // BgpAfiSafiWildcardNotSupported return true if bgp afi/safi wildcard query is not supported.
@@ -1188,57 +1206,57 @@ func CiscoPreFECBERInactiveValue(dut *ondatra.DUTDevice) bool {
 // Use of this deviation is permitted if a query using an explicit key is supported (such as
 // `oc.BgpTypes_AFI_SAFI_TYPE_IPV4_UNICAST`).
 func BgpAfiSafiWildcardNotSupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpAfiSafiWildcardNotSupported()
+	return recorded(dut.ID(), "bgp_afi_safi_wildcard_not_supported", lookupDUTDeviations(dut).GetBgpAfiSafiWildcardNotSupported())
 }
 
 // NoZeroSuppression returns true if device wants to remove zero suppression
 func NoZeroSuppression(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNoZeroSuppression()
+	return recorded(dut.ID(), "no_zero_suppression", lookupDUTDeviations(dut).GetNoZeroSuppression())
 }
 
 // IsisInterfaceLevelPassiveUnsupported returns true for devices that do not support passive leaf
 func IsisInterfaceLevelPassiveUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisInterfaceLevelPassiveUnsupported()
+	return recorded(dut.ID(), "isis_interface_level_passive_unsupported", lookupDUTDeviations(dut).GetIsisInterfaceLevelPassiveUnsupported())
 }
 
 // IsisDisSysidUnsupported returns true for devices that do not support dis-system-id leaf
 func IsisDisSysidUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisDisSysidUnsupported()
+	return recorded(dut.ID(), "isis_dis_sysid_unsupported", lookupDUTDeviations(dut).GetIsisDisSysidUnsupported())
 }
 
 // IsisDatabaseOverloadsUnsupported returns true for devices that do not support database-overloads leaf
 func IsisDatabaseOverloadsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisDatabaseOverloadsUnsupported()
+	return recorded(dut.ID(), "isis_database_overloads_unsupported", lookupDUTDeviations(dut).GetIsisDatabaseOverloadsUnsupported())
 }
 
 // EnableTableConnections returns true if admin state of tableconnections needs to be enabled in SRL native model
 func EnableTableConnections(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetEnableTableConnections()
+	return recorded(dut.ID(), "enable_table_connections", lookupDUTDeviations(dut).GetEnableTableConnections())
 }
 
 // TcDefaultImportPolicyUnsupported returns true if default import policy for table connection is unsupported
 func TcDefaultImportPolicyUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTcDefaultImportPolicyUnsupported()
+	return recorded(dut.ID(), "tc_default_import_policy_unsupported", lookupDUTDeviations(dut).GetTcDefaultImportPolicyUnsupported())
 }
 
 // TcMetricPropagationUnsupported returns true if metric propagation for table connection is unsupported
 func TcMetricPropagationUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTcMetricPropagationUnsupported()
+	return recorded(dut.ID(), "tc_metric_propagation_unsupported", lookupDUTDeviations(dut).GetTcMetricPropagationUnsupported())
 }
 
 // TcAttributePropagationUnsupported returns true if attribute propagation for table connection is unsupported
 func TcAttributePropagationUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTcAttributePropagationUnsupported()
+	return recorded(dut.ID(), "tc_attribute_propagation_unsupported", lookupDUTDeviations(dut).GetTcAttributePropagationUnsupported())
 }
 
 // TcSubscriptionUnsupported returns true if subscription for table connection is unsupported
 func TcSubscriptionUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTcSubscriptionUnsupported()
+	return recorded(dut.ID(), "tc_subscription_unsupported", lookupDUTDeviations(dut).GetTcSubscriptionUnsupported())
 }
 
 // DefaultBgpInstanceName returns bgp instance name as set in deviation to override default value "DEFAULT"
 func DefaultBgpInstanceName(dut *ondatra.DUTDevice) string {
-	if dbin := lookupDUTDeviations(dut).GetDefaultBgpInstanceName(); dbin != "" {
+	if dbin := recorded(dut.ID(), "default_bgp_instance_name", lookupDUTDeviations(dut).GetDefaultBgpInstanceName()); dbin != "" {
 		return dbin
 	}
 	return "DEFAULT"
@@ -1246,558 +1264,558 @@ func DefaultBgpInstanceName(dut *ondatra.DUTDevice) string {
 
 // ChannelRateClassParametersUnsupported returns true if channel rate class parameters are unsupported
 func ChannelRateClassParametersUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetChannelAssignmentRateClassParametersUnsupported()
+	return recorded(dut.ID(), "channel_assignment_rate_class_parameters_unsupported", lookupDUTDeviations(dut).GetChannelAssignmentRateClassParametersUnsupported())
 }
 
 // QosSchedulerIngressPolicer returns true if qos ingress policing is unsupported
 func QosSchedulerIngressPolicer(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosSchedulerIngressPolicerUnsupported()
+	return recorded(dut.ID(), "qos_scheduler_ingress_policer_unsupported", lookupDUTDeviations(dut).GetQosSchedulerIngressPolicerUnsupported())
 }
 
 // GribiEncapHeaderUnsupported returns true if gribi encap header is unsupported
 func GribiEncapHeaderUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGribiEncapHeaderUnsupported()
+	return recorded(dut.ID(), "gribi_encap_header_unsupported", lookupDUTDeviations(dut).GetGribiEncapHeaderUnsupported())
 }
 
 // P4RTCapabilitiesUnsupported returns true for devices that don't support P4RT Capabilities rpc.
 func P4RTCapabilitiesUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetP4RtCapabilitiesUnsupported()
+	return recorded(dut.ID(), "p4rt_capabilities_unsupported", lookupDUTDeviations(dut).GetP4RtCapabilitiesUnsupported())
 }
 
 // GNMIGetOnRootUnsupported returns true if the device does not support gNMI get on root.
 func GNMIGetOnRootUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGnmiGetOnRootUnsupported()
+	return recorded(dut.ID(), "gnmi_get_on_root_unsupported", lookupDUTDeviations(dut).GetGnmiGetOnRootUnsupported())
 }
 
 // PacketProcessingAggregateDropsUnsupported returns true if the device does not support packet processing aggregate drops.
 func PacketProcessingAggregateDropsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPacketProcessingAggregateDropsUnsupported()
+	return recorded(dut.ID(), "packet_processing_aggregate_drops_unsupported", lookupDUTDeviations(dut).GetPacketProcessingAggregateDropsUnsupported())
 }
 
 // FragmentTotalDropsUnsupported returns true if the device does not support fragment total drops.
 func FragmentTotalDropsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetFragmentTotalDropsUnsupported()
+	return recorded(dut.ID(), "fragment_total_drops_unsupported", lookupDUTDeviations(dut).GetFragmentTotalDropsUnsupported())
 }
 
 // BgpPrefixsetReqRoutepolRef returns true if devices needs route policy reference to stream prefix set info.
 func BgpPrefixsetReqRoutepolRef(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpPrefixsetReqRoutepolRef()
+	return recorded(dut.ID(), "bgp_prefixset_req_routepol_ref", lookupDUTDeviations(dut).GetBgpPrefixsetReqRoutepolRef())
 }
 
 // OperStatusForIcUnsupported return true if oper-status leaf is unsupported for Integration Circuit
 func OperStatusForIcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOperStatusForIcUnsupported()
+	return recorded(dut.ID(), "oper_status_for_ic_unsupported", lookupDUTDeviations(dut).GetOperStatusForIcUnsupported())
 }
 
 // BgpAspathsetUnsupported returns true if as-path-set for bgp-defined-sets is unsupported
 func BgpAspathsetUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpAspathsetUnsupported()
+	return recorded(dut.ID(), "bgp_aspathset_unsupported", lookupDUTDeviations(dut).GetBgpAspathsetUnsupported())
 }
 
 // ExplicitDcoConfig returns true if a user-configured value is required in module-functional-type for the transceiver
 func ExplicitDcoConfig(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetExplicitDcoConfig()
+	return recorded(dut.ID(), "explicit_dco_config", lookupDUTDeviations(dut).GetExplicitDcoConfig())
 }
 
 // VerifyExpectedBreakoutSupportedConfig is to skip checking for breakout config mode.
 func VerifyExpectedBreakoutSupportedConfig(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetVerifyExpectedBreakoutSupportedConfig()
+	return recorded(dut.ID(), "verify_expected_breakout_supported_config", lookupDUTDeviations(dut).GetVerifyExpectedBreakoutSupportedConfig())
 }
 
 // SrIgpConfigUnsupported return true if SR IGP config is not supported
 func SrIgpConfigUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSrIgpConfigUnsupported()
+	return recorded(dut.ID(), "sr_igp_config_unsupported", lookupDUTDeviations(dut).GetSrIgpConfigUnsupported())
 }
 
 // SetISISAuthWithInterfaceAuthenticationContainer returns true if Isis Authentication is blocked for one level specific config for P2P links, and the corresponding hello-authentication leafs can be set with ISIS Interface/Authentication container.
 func SetISISAuthWithInterfaceAuthenticationContainer(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSetIsisAuthWithInterfaceAuthenticationContainer()
+	return recorded(dut.ID(), "set_isis_auth_with_interface_authentication_container", lookupDUTDeviations(dut).GetSetIsisAuthWithInterfaceAuthenticationContainer())
 }
 
 // GreGueTunnelInterfaceOcUnsupported returns true if GRE/GUE tunnel interface oc is unsupported
 func GreGueTunnelInterfaceOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGreGueTunnelInterfaceOcUnsupported()
+	return recorded(dut.ID(), "gre_gue_tunnel_interface_oc_unsupported", lookupDUTDeviations(dut).GetGreGueTunnelInterfaceOcUnsupported())
 }
 
 // LoadIntervalNotSupported returns true if load interval is not supported on vendors
 func LoadIntervalNotSupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLoadIntervalNotSupported()
+	return recorded(dut.ID(), "load_interval_not_supported", lookupDUTDeviations(dut).GetLoadIntervalNotSupported())
 }
 
 // SkipOpticalChannelOutputPowerInterval returns true if devices do not support opticalchannel output-power interval leaf
 func SkipOpticalChannelOutputPowerInterval(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipOpticalChannelOutputPowerInterval()
+	return recorded(dut.ID(), "skip_optical_channel_output_power_interval", lookupDUTDeviations(dut).GetSkipOpticalChannelOutputPowerInterval())
 }
 
 // SkipTransceiverDescription returns true if devices do not support transceiver description leaf
 func SkipTransceiverDescription(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipTransceiverDescription()
+	return recorded(dut.ID(), "skip_transceiver_description", lookupDUTDeviations(dut).GetSkipTransceiverDescription())
 }
 
 // ContainerzOCUnsupported returns true if devices cannot configure containerz via OpenConfig
 func ContainerzOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetContainerzOcUnsupported()
+	return recorded(dut.ID(), "containerz_oc_unsupported", lookupDUTDeviations(dut).GetContainerzOcUnsupported())
 }
 
 // ContainerzRetrieveLogsUnsupported returns true if Containerz log retrieval is unsupported.
 // https://partnerissuetracker.corp.google.com/issues/510547636
 func ContainerzRetrieveLogsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetContainerzRetrieveLogsUnsupported()
+	return recorded(dut.ID(), "containerz_retrieve_logs_unsupported", lookupDUTDeviations(dut).GetContainerzRetrieveLogsUnsupported())
 }
 
 // NextHopGroupOCUnsupported returns true if devices do not support next-hop-group config
 func NextHopGroupOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNextHopGroupConfigUnsupported()
+	return recorded(dut.ID(), "next_hop_group_config_unsupported", lookupDUTDeviations(dut).GetNextHopGroupConfigUnsupported())
 }
 
 // QosShaperOCUnsupported returns true if qos shaper config is unsupported
 func QosShaperOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosShaperConfigUnsupported()
+	return recorded(dut.ID(), "qos_shaper_config_unsupported", lookupDUTDeviations(dut).GetQosShaperConfigUnsupported())
 }
 
 // EthernetOverMPLSogreOCUnsupported returns true if ethernet over mplsogre is unsupported
 func EthernetOverMPLSogreOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetEthernetOverMplsogreUnsupported()
+	return recorded(dut.ID(), "ethernet_over_mplsogre_unsupported", lookupDUTDeviations(dut).GetEthernetOverMplsogreUnsupported())
 }
 
 // SflowOCUnsupported returns true if sflow is unsupported
 func SflowOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSflowUnsupported()
+	return recorded(dut.ID(), "sflow_unsupported", lookupDUTDeviations(dut).GetSflowUnsupported())
 }
 
 // MplsOCUnsupported returns true if mpls is unsupported
 func MplsOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMplsUnsupported()
+	return recorded(dut.ID(), "mpls_unsupported", lookupDUTDeviations(dut).GetMplsUnsupported())
 }
 
 // MacsecOCUnsupported returns true if macsec is unsupported
 func MacsecOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMacsecUnsupported()
+	return recorded(dut.ID(), "macsec_unsupported", lookupDUTDeviations(dut).GetMacsecUnsupported())
 }
 
 // GueGreDecapOCUnsupported returns true if gue gre decap is unsupported
 func GueGreDecapOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGueGreDecapUnsupported()
+	return recorded(dut.ID(), "gue_gre_decap_unsupported", lookupDUTDeviations(dut).GetGueGreDecapUnsupported())
 }
 
 // MplsLabelClassificationOCUnsupported returns true if mpls label classification is unsupported
 func MplsLabelClassificationOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMplsLabelClassificationUnsupported()
+	return recorded(dut.ID(), "mpls_label_classification_unsupported", lookupDUTDeviations(dut).GetMplsLabelClassificationUnsupported())
 }
 
 // LocalProxyOCUnsupported returns true if local proxy is unsupported
 func LocalProxyOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLocalProxyUnsupported()
+	return recorded(dut.ID(), "local_proxy_unsupported", lookupDUTDeviations(dut).GetLocalProxyUnsupported())
 }
 
 // StaticMplsOCUnsupported returns true if static mpls is unsupported
 func StaticMplsOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStaticMplsUnsupported()
+	return recorded(dut.ID(), "static_mpls_unsupported", lookupDUTDeviations(dut).GetStaticMplsUnsupported())
 }
 
 // QosClassificationOCUnsupported returns true if qos classification is unsupported
 func QosClassificationOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosClassificationUnsupported()
+	return recorded(dut.ID(), "qos_classification_unsupported", lookupDUTDeviations(dut).GetQosClassificationUnsupported())
 }
 
 // PolicyForwardingOCUnsupported returns true if policy forwarding is unsupported
 func PolicyForwardingOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPolicyForwardingUnsupported()
+	return recorded(dut.ID(), "policy_forwarding_unsupported", lookupDUTDeviations(dut).GetPolicyForwardingUnsupported())
 }
 
 // InterfacePolicyForwardingOCUnsupported returns true if interface policy forwarding is unsupported
 func InterfacePolicyForwardingOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfacePolicyForwardingUnsupported()
+	return recorded(dut.ID(), "interface_policy_forwarding_unsupported", lookupDUTDeviations(dut).GetInterfacePolicyForwardingUnsupported())
 }
 
 // GueGreDecapUnsupported returns true if gue or gre decap is unsupported
 func GueGreDecapUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGueGreDecapUnsupported()
+	return recorded(dut.ID(), "gue_gre_decap_unsupported", lookupDUTDeviations(dut).GetGueGreDecapUnsupported())
 }
 
 // StaticMplsUnsupported returns true if static mpls is unsupported
 func StaticMplsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStaticMplsUnsupported()
+	return recorded(dut.ID(), "static_mpls_unsupported", lookupDUTDeviations(dut).GetStaticMplsUnsupported())
 }
 
 // QosShaperStateOCUnsupported returns true if qos shaper state is unsupported
 func QosShaperStateOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosShaperStateUnsupported()
+	return recorded(dut.ID(), "qos_shaper_state_unsupported", lookupDUTDeviations(dut).GetQosShaperStateUnsupported())
 }
 
 // CfmOCUnsupported returns true if CFM is unsupported
 func CfmOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetCfmUnsupported()
+	return recorded(dut.ID(), "cfm_unsupported", lookupDUTDeviations(dut).GetCfmUnsupported())
 }
 
 // LabelRangeOCUnsupported returns true if label range is unsupported
 func LabelRangeOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLabelRangeUnsupported()
+	return recorded(dut.ID(), "label_range_unsupported", lookupDUTDeviations(dut).GetLabelRangeUnsupported())
 }
 
 // StaticArpOCUnsupported returns true if static arp is unsupported
 func StaticArpOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStaticArpUnsupported()
+	return recorded(dut.ID(), "static_arp_unsupported", lookupDUTDeviations(dut).GetStaticArpUnsupported())
 }
 
 // BgpDistanceOcPathUnsupported returns true if BGP Distance OC telemetry path is not supported.
 func BgpDistanceOcPathUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpDistanceOcPathUnsupported()
+	return recorded(dut.ID(), "bgp_distance_oc_path_unsupported", lookupDUTDeviations(dut).GetBgpDistanceOcPathUnsupported())
 }
 
 // IsisMplsUnsupported returns true if there's no OC support for MPLS under ISIS
 func IsisMplsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisMplsUnsupported()
+	return recorded(dut.ID(), "isis_mpls_unsupported", lookupDUTDeviations(dut).GetIsisMplsUnsupported())
 }
 
 // AutoNegotiateUnsupported returns true if there's no OC support for auto-negotiate
 func AutoNegotiateUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAutoNegotiateUnsupported()
+	return recorded(dut.ID(), "auto_negotiate_unsupported", lookupDUTDeviations(dut).GetAutoNegotiateUnsupported())
 }
 
 // DuplexModeUnsupported returns true if there's no OC support for duplex-mode
 func DuplexModeUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDuplexModeUnsupported()
+	return recorded(dut.ID(), "duplex_mode_unsupported", lookupDUTDeviations(dut).GetDuplexModeUnsupported())
 }
 
 // PortSpeedUnsupported returns true if there's no OC support for port-speed
 func PortSpeedUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPortSpeedUnsupported()
+	return recorded(dut.ID(), "port_speed_unsupported", lookupDUTDeviations(dut).GetPortSpeedUnsupported())
 }
 
 // PolicyForwardingToNextHopOcUnsupported returns true if policy forwarding to next hop is not supported on vendors
 func PolicyForwardingToNextHopOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPolicyForwardingToNextHopOcUnsupported()
+	return recorded(dut.ID(), "policy_forwarding_to_next_hop_oc_unsupported", lookupDUTDeviations(dut).GetPolicyForwardingToNextHopOcUnsupported())
 }
 
 // BGPSetMedActionUnsupported returns true if there's no OC support for BGP set med action
 func BGPSetMedActionUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpSetMedActionUnsupported()
+	return recorded(dut.ID(), "bgp_set_med_action_unsupported", lookupDUTDeviations(dut).GetBgpSetMedActionUnsupported())
 }
 
 // ReducedEcmpSetOnMixedEncapDecapNh returns true if mixed encap and decap next hops are not supported over ecmp.
 // Nokia: b/459893133
 func ReducedEcmpSetOnMixedEncapDecapNh(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetReducedEcmpSetOnMixedEncapDecapNh()
+	return recorded(dut.ID(), "reduced_ecmp_set_on_mixed_encap_decap_nh", lookupDUTDeviations(dut).GetReducedEcmpSetOnMixedEncapDecapNh())
 }
 
 // NumPhysyicalChannelsUnsupported returns true if there's no OC support for num-physical-channels
 func NumPhysyicalChannelsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNumPhysicalChannelsUnsupported()
+	return recorded(dut.ID(), "num_physical_channels_unsupported", lookupDUTDeviations(dut).GetNumPhysicalChannelsUnsupported())
 }
 
 // UseOldOCPathStaticLspNh returns true if the old OC path for static lsp next-hop is used
 func UseOldOCPathStaticLspNh(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetUseOldOcPathStaticLspNh()
+	return recorded(dut.ID(), "use_old_oc_path_static_lsp_nh", lookupDUTDeviations(dut).GetUseOldOcPathStaticLspNh())
 }
 
 // ConfigLeafCreateRequired returns true if leaf creation is required
 func ConfigLeafCreateRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetConfigLeafCreateRequired()
+	return recorded(dut.ID(), "config_leaf_create_required", lookupDUTDeviations(dut).GetConfigLeafCreateRequired())
 }
 
 // FrBreakoutFix returns true if the fix is needed
 // Arista: https://issuetracker.google.com/426375784
 func FrBreakoutFix(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetFrBreakoutFix()
+	return recorded(dut.ID(), "fr_breakout_fix", lookupDUTDeviations(dut).GetFrBreakoutFix())
 }
 
 // SkipInterfaceNameCheck returns if device requires skipping the interface name check.
 func SkipInterfaceNameCheck(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipInterfaceNameCheck()
+	return recorded(dut.ID(), "skip_interface_name_check", lookupDUTDeviations(dut).GetSkipInterfaceNameCheck())
 }
 
 // UnsupportedQoSOutputServicePolicy returns true if devices do not support qos output service-policy
 func UnsupportedQoSOutputServicePolicy(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetUnsupportedQosOutputServicePolicy()
+	return recorded(dut.ID(), "unsupported_qos_output_service_policy", lookupDUTDeviations(dut).GetUnsupportedQosOutputServicePolicy())
 }
 
 // InterfaceOutputQueueNonStandardName returns true if devices have non-standard output queue names
 func InterfaceOutputQueueNonStandardName(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceOutputQueueNonStandardName()
+	return recorded(dut.ID(), "interface_output_queue_non_standard_name", lookupDUTDeviations(dut).GetInterfaceOutputQueueNonStandardName())
 }
 
 // MplsExpIngressClassifierOcUnsupported returns true if devices do not support classifying ingress packets based on the MPLS exp field
 func MplsExpIngressClassifierOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMplsExpIngressClassifierOcUnsupported()
+	return recorded(dut.ID(), "mpls_exp_ingress_classifier_oc_unsupported", lookupDUTDeviations(dut).GetMplsExpIngressClassifierOcUnsupported())
 }
 
 // DefaultNoIgpMetricPropagation returns true for devices that do not propagate IGP metric through redistribution
 func DefaultNoIgpMetricPropagation(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDefaultNoIgpMetricPropagation()
+	return recorded(dut.ID(), "default_no_igp_metric_propagation", lookupDUTDeviations(dut).GetDefaultNoIgpMetricPropagation())
 }
 
 // SkipBgpPeerGroupSendCommunityType return true if device needs to skip setting BGP send-community-type for peer group
 func SkipBgpPeerGroupSendCommunityType(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipBgpPeerGroupSendCommunityType()
+	return recorded(dut.ID(), "skip_bgp_peer_group_send_community_type", lookupDUTDeviations(dut).GetSkipBgpPeerGroupSendCommunityType())
 }
 
 // ExplicitSwapSrcDstMacNeededForLoopbackMode returns true if device needs to explicitly set swap-src-dst-mac for loopback mode
 func ExplicitSwapSrcDstMacNeededForLoopbackMode(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetExplicitSwapSrcDstMacNeededForLoopbackMode()
+	return recorded(dut.ID(), "explicit_swap_src_dst_mac_needed_for_loopback_mode", lookupDUTDeviations(dut).GetExplicitSwapSrcDstMacNeededForLoopbackMode())
 }
 
 // LinkLocalInsteadOfNh returns true if device requires link-local instead of NH.
 func LinkLocalInsteadOfNh(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLinkLocalInsteadOfNh()
+	return recorded(dut.ID(), "link_local_instead_of_nh", lookupDUTDeviations(dut).GetLinkLocalInsteadOfNh())
 }
 
 // LowScaleAft returns if device requires link-local instead of NH.
 func LowScaleAft(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLowScaleAft()
+	return recorded(dut.ID(), "low_scale_aft", lookupDUTDeviations(dut).GetLowScaleAft())
 }
 
 // MissingSystemDescriptionConfigPath returns true if device does not support config lldp system-description leaf
 func MissingSystemDescriptionConfigPath(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMissingSystemDescriptionConfigPath()
+	return recorded(dut.ID(), "missing_system_description_config_path", lookupDUTDeviations(dut).GetMissingSystemDescriptionConfigPath())
 }
 
 // NonIntervalFecErrorCounter returns true if FEC uncorrectable errors accumulate over time and are not cleared unless the component is reset on target
 func NonIntervalFecErrorCounter(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNonIntervalFecErrorCounter()
+	return recorded(dut.ID(), "non_interval_fec_error_counter", lookupDUTDeviations(dut).GetNonIntervalFecErrorCounter())
 }
 
 // NtpSourceAddressUnsupported returns true if NTP source address is not supported
 func NtpSourceAddressUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNtpSourceAddressUnsupported()
+	return recorded(dut.ID(), "ntp_source_address_unsupported", lookupDUTDeviations(dut).GetNtpSourceAddressUnsupported())
 }
 
 // StaticMplsLspOCUnsupported returns true if static mpls lsp parameters are unsupported
 func StaticMplsLspOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStaticMplsLspOcUnsupported()
+	return recorded(dut.ID(), "static_mpls_lsp_oc_unsupported", lookupDUTDeviations(dut).GetStaticMplsLspOcUnsupported())
 }
 
 // GreDecapsulationOCUnsupported returns true if decapsulation is not supported
 func GreDecapsulationOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGreDecapsulationOcUnsupported()
+	return recorded(dut.ID(), "gre_decapsulation_oc_unsupported", lookupDUTDeviations(dut).GetGreDecapsulationOcUnsupported())
 }
 
 // IsisSrgbSrlbUnsupported returns true if SRLB and SRGB configuration is not effective with OC config
 func IsisSrgbSrlbUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisSrgbSrlbUnsupported()
+	return recorded(dut.ID(), "isis_srgb_srlb_unsupported", lookupDUTDeviations(dut).GetIsisSrgbSrlbUnsupported())
 }
 
 // IsisSrPrefixSegmentConfigUnsupported returns true if Isis Prefix Segment is not supported
 func IsisSrPrefixSegmentConfigUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisSrPrefixSegmentConfigUnsupported()
+	return recorded(dut.ID(), "isis_sr_prefix_segment_config_unsupported", lookupDUTDeviations(dut).GetIsisSrPrefixSegmentConfigUnsupported())
 }
 
 // IsisSrNodeSegmentConfigUnsupported returns true if ISIS SR node segment config is unsupported
 func IsisSrNodeSegmentConfigUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisSrNodeSegmentConfigUnsupported()
+	return recorded(dut.ID(), "isis_sr_node_segment_config_unsupported", lookupDUTDeviations(dut).GetIsisSrNodeSegmentConfigUnsupported())
 }
 
 // IsisSrNoPhpRequired returns true if the device requires the no-php flag for ISIS SR prefix and node segments
 func IsisSrNoPhpRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisSrNoPhpRequired()
+	return recorded(dut.ID(), "isis_sr_no_php_required", lookupDUTDeviations(dut).GetIsisSrNoPhpRequired())
 }
 
 // SflowIngressMinSamplingRate returns the minimum sampling rate supported for sflow ingress on the device.
 func SflowIngressMinSamplingRate(dut *ondatra.DUTDevice) uint32 {
-	return lookupDUTDeviations(dut).GetSflowIngressMinSamplingRate()
+	return recorded(dut.ID(), "sflow_ingress_min_sampling_rate", lookupDUTDeviations(dut).GetSflowIngressMinSamplingRate())
 }
 
 // QosRemarkOCUnsupported returns true if Qos remark parameters are unsupported
 func QosRemarkOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosRemarkOcUnsupported()
+	return recorded(dut.ID(), "qos_remark_oc_unsupported", lookupDUTDeviations(dut).GetQosRemarkOcUnsupported())
 }
 
 // PolicyForwardingGreEncapsulationOcUnsupported returns true if policy forwarding GRE encapsulation is not supported on vendors
 func PolicyForwardingGreEncapsulationOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPolicyForwardingGreEncapsulationOcUnsupported()
+	return recorded(dut.ID(), "policy_forwarding_gre_encapsulation_oc_unsupported", lookupDUTDeviations(dut).GetPolicyForwardingGreEncapsulationOcUnsupported())
 }
 
 // PolicyRuleCountersOCUnsupported returns true if policy forwarding Rule Counters is not supported on vendors
 func PolicyRuleCountersOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPolicyRuleCountersOcUnsupported()
+	return recorded(dut.ID(), "policy_rule_counters_oc_unsupported", lookupDUTDeviations(dut).GetPolicyRuleCountersOcUnsupported())
 }
 
 // OTNToETHAssignment returns true if the device must have the OTN to ETH assignment.
 func OTNToETHAssignment(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOtnToEthAssignment()
+	return recorded(dut.ID(), "otn_to_eth_assignment", lookupDUTDeviations(dut).GetOtnToEthAssignment())
 }
 
 // NetworkInstanceImportExportPolicyOCUnsupported returns true if network instance import/export policy is not supported.
 func NetworkInstanceImportExportPolicyOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNetworkInstanceImportExportPolicyOcUnsupported()
+	return recorded(dut.ID(), "network_instance_import_export_policy_oc_unsupported", lookupDUTDeviations(dut).GetNetworkInstanceImportExportPolicyOcUnsupported())
 }
 
 // SkipOrigin returns true if the device does not support the 'origin' field in gNMI/gNOI RPC paths.
 func SkipOrigin(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipOrigin()
+	return recorded(dut.ID(), "skip_origin", lookupDUTDeviations(dut).GetSkipOrigin())
 }
 
 // PredefinedMaxEcmpPaths returns true if max ecmp paths are predefined.
 func PredefinedMaxEcmpPaths(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPredefinedMaxEcmpPaths()
+	return recorded(dut.ID(), "predefined_max_ecmp_paths", lookupDUTDeviations(dut).GetPredefinedMaxEcmpPaths())
 }
 
 // DecapsulateGueOCUnsupported returns true if decapsulation group is not supported
 func DecapsulateGueOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDecapsulateGueOcUnsupported()
+	return recorded(dut.ID(), "decapsulate_gue_oc_unsupported", lookupDUTDeviations(dut).GetDecapsulateGueOcUnsupported())
 }
 
 // LinePortUnsupported returns whether the DUT does not support line-port configuration on optical channel components.
 func LinePortUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLinePortUnsupported()
+	return recorded(dut.ID(), "line_port_unsupported", lookupDUTDeviations(dut).GetLinePortUnsupported())
 }
 
 // UseBgpSetCommunityOptionTypeReplace returns true if BGP community set REPLACE
 // option is required
 func UseBgpSetCommunityOptionTypeReplace(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetUseBgpSetCommunityOptionTypeReplace()
+	return recorded(dut.ID(), "use_bgp_set_community_option_type_replace", lookupDUTDeviations(dut).GetUseBgpSetCommunityOptionTypeReplace())
 }
 
 // GlobalMaxEcmpPathsUnsupported returns true if Max ECMP path on global level is unsupported
 func GlobalMaxEcmpPathsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGlobalMaxEcmpPathsUnsupported()
+	return recorded(dut.ID(), "global_max_ecmp_paths_unsupported", lookupDUTDeviations(dut).GetGlobalMaxEcmpPathsUnsupported())
 }
 
 // QosTwoRateThreeColorPolicerOCUnsupported returns true if the device does not support QoS two-rate-three-color policer.
 // Arista: https://partnerissuetracker.corp.google.com/issues/442749011
 func QosTwoRateThreeColorPolicerOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetQosTwoRateThreeColorPolicerOcUnsupported()
+	return recorded(dut.ID(), "qos_two_rate_three_color_policer_oc_unsupported", lookupDUTDeviations(dut).GetQosTwoRateThreeColorPolicerOcUnsupported())
 }
 
 // LoadBalancePolicyOCUnsupported returns true if load-balancing policy configuration is not supported through OpenConfig.
 func LoadBalancePolicyOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLoadBalancePolicyOcUnsupported()
+	return recorded(dut.ID(), "load_balance_policy_oc_unsupported", lookupDUTDeviations(dut).GetLoadBalancePolicyOcUnsupported())
 }
 
 // GribiRecordsUnsupported returns true if Gribi records creation is not supported through OpenConfig.
 func GribiRecordsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGribiRecordsUnsupported()
+	return recorded(dut.ID(), "gribi_records_unsupported", lookupDUTDeviations(dut).GetGribiRecordsUnsupported())
 }
 
 // CiscoxrLaserFt returns the functional translator to be used for translating
 // transceiver threshold leaves.
 func CiscoxrLaserFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetCiscoxrLaserFt()
+	return recorded(dut.ID(), "ciscoxr_laser_ft", lookupDUTDeviations(dut).GetCiscoxrLaserFt())
 }
 
 // BreakoutModeUnsupportedForEightHundredGb returns true if the device does not support breakout mode for 800G ports.
 func BreakoutModeUnsupportedForEightHundredGb(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBreakoutModeUnsupportedForEightHundredGb()
+	return recorded(dut.ID(), "breakout_mode_unsupported_for_eight_hundred_gb", lookupDUTDeviations(dut).GetBreakoutModeUnsupportedForEightHundredGb())
 }
 
 // PortSpeedDuplexModeUnsupportedForInterfaceConfig returns true if the device does not support port speed and duplex mode for interface config.
 func PortSpeedDuplexModeUnsupportedForInterfaceConfig(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPortSpeedDuplexModeUnsupportedForInterfaceConfig()
+	return recorded(dut.ID(), "port_speed_duplex_mode_unsupported_for_interface_config", lookupDUTDeviations(dut).GetPortSpeedDuplexModeUnsupportedForInterfaceConfig())
 }
 
 // ExplicitBreakoutInterfaceConfig returns true if the device needs explicit breakout interface config.
 func ExplicitBreakoutInterfaceConfig(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetExplicitBreakoutInterfaceConfig()
+	return recorded(dut.ID(), "explicit_breakout_interface_config", lookupDUTDeviations(dut).GetExplicitBreakoutInterfaceConfig())
 }
 
 // TelemetryNotSupportedForLowPriorityNh returns true if OC state path for the lower priority next hop not supported
 func TelemetryNotSupportedForLowPriorityNh(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTelemetryNotSupportedForLowPriorityNh()
+	return recorded(dut.ID(), "telemetry_not_supported_for_low_priority_nh", lookupDUTDeviations(dut).GetTelemetryNotSupportedForLowPriorityNh())
 }
 
 // MatchAsPathSetUnsupported returns true if match-as-path-set policy configuration is not supported
 func MatchAsPathSetUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMatchAsPathSetUnsupported()
+	return recorded(dut.ID(), "match_as_path_set_unsupported", lookupDUTDeviations(dut).GetMatchAsPathSetUnsupported())
 }
 
 // SameAfiSafiAndPeergroupPoliciesUnsupported returns true if configuring same apply-policy under peer-group and peer-group/afi-safi is unsupported
 func SameAfiSafiAndPeergroupPoliciesUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSameAfiSafiAndPeergroupPoliciesUnsupported()
+	return recorded(dut.ID(), "same_afi_safi_and_peergroup_policies_unsupported", lookupDUTDeviations(dut).GetSameAfiSafiAndPeergroupPoliciesUnsupported())
 }
 
 // SyslogOCUnsupported returns true if the device does not support syslog OC configuration for below OC paths.
 // '/system/logging/remote-servers/remote-server/config/network-instance'
 func SyslogOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSyslogOcUnsupported()
+	return recorded(dut.ID(), "syslog_oc_unsupported", lookupDUTDeviations(dut).GetSyslogOcUnsupported())
 }
 
 // SIDPerInterfaceCounterUnsupported return true if device does not supprt mpls/signaling-protocols/segment-routing/interfaces/interface/sid-counters/sid-counter/
 func SIDPerInterfaceCounterUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSidPerInterfaceCounterUnsupported()
+	return recorded(dut.ID(), "sid_per_interface_counter_unsupported", lookupDUTDeviations(dut).GetSidPerInterfaceCounterUnsupported())
 }
 
 // TransceiverConfigEnableUnsupported returns true if devices cannot set transceiver config enable
 func TransceiverConfigEnableUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTransceiverConfigEnableUnsupported()
+	return recorded(dut.ID(), "transceiver_config_enable_unsupported", lookupDUTDeviations(dut).GetTransceiverConfigEnableUnsupported())
 }
 
 // AFTSummaryOCUnsupported returns true "/network-instances/network-instance/afts/aft-summaries" OC path is not supported.
 func AFTSummaryOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAftSummaryOcUnsupported()
+	return recorded(dut.ID(), "aft_summary_oc_unsupported", lookupDUTDeviations(dut).GetAftSummaryOcUnsupported())
 }
 
 // ISISLSPTlvsOCUnsupported returns true if "/network-instances/network-instance/protocols/protocol/isis/levels/level/link-state-database/lsp/tlvs" OC path is not supported.
 func ISISLSPTlvsOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisLspTlvsOcUnsupported()
+	return recorded(dut.ID(), "isis_lsp_tlvs_oc_unsupported", lookupDUTDeviations(dut).GetIsisLspTlvsOcUnsupported())
 }
 
 // ISISAdjacencyStreamUnsupported returns if "/network-instances/network-instance/protocols/protocol/isis/interfaces/interface/levels/level/adjacencies" OC path
 // is not supported or malfunctioning when STREAM subscription is used .
 func ISISAdjacencyStreamUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIsisAdjacencyStreamUnsupported()
+	return recorded(dut.ID(), "isis_adjacency_stream_unsupported", lookupDUTDeviations(dut).GetIsisAdjacencyStreamUnsupported())
 }
 
 // LocalhostForContainerz returns true if the device uses an IPv6 address instead of localhost.
 // TODO enhancement: this should be renamed to LocalhostForContainerzUnsupported for clarity
 func LocalhostForContainerz(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLocalhostForContainerz()
+	return recorded(dut.ID(), "localhost_for_containerz", lookupDUTDeviations(dut).GetLocalhostForContainerz())
 }
 
 // AggregateBandwidthPolicyActionUnsupported returns true if device does not support aggregate bandwidth policy action.
 func AggregateBandwidthPolicyActionUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAggregateBandwidthPolicyActionUnsupported()
+	return recorded(dut.ID(), "aggregate_bandwidth_policy_action_unsupported", lookupDUTDeviations(dut).GetAggregateBandwidthPolicyActionUnsupported())
 }
 
 // AutoLinkBandwidthUnsupported returns true if device does not support auto link bandwidth.
 func AutoLinkBandwidthUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAutoLinkBandwidthUnsupported()
+	return recorded(dut.ID(), "auto_link_bandwidth_unsupported", lookupDUTDeviations(dut).GetAutoLinkBandwidthUnsupported())
 }
 
 // AdvertisedCumulativeLBwOCUnsupported returns true if device does not support oc state path for advertised cumulative link bandwidth.
 func AdvertisedCumulativeLBwOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAdvertisedCumulativeLbwOcUnsupported()
+	return recorded(dut.ID(), "advertised_cumulative_lbw_oc_unsupported", lookupDUTDeviations(dut).GetAdvertisedCumulativeLbwOcUnsupported())
 }
 
 // DisableHardwareNexthopProxy returns true if the device requires disabling hardware nexthop proxying
 func DisableHardwareNexthopProxy(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDisableHardwareNexthopProxy()
+	return recorded(dut.ID(), "disable_hardware_nexthop_proxy", lookupDUTDeviations(dut).GetDisableHardwareNexthopProxy())
 }
 
 // URPFConfigOCUnsupported returns true if OC does not support configuring uRPF.
 func URPFConfigOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfacePolicyForwardingUnsupported()
+	return recorded(dut.ID(), "urpf_config_oc_unsupported", lookupDUTDeviations(dut).GetUrpfConfigOcUnsupported())
 }
 
 // StaticRouteNextNetworkInstanceOCUnsupported returns true for devices that don't support NextNetworkInstance of static route next hop.
 func StaticRouteNextNetworkInstanceOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStaticRouteNextNetworkInstanceOcUnsupported()
+	return recorded(dut.ID(), "static_route_next_network_instance_oc_unsupported", lookupDUTDeviations(dut).GetStaticRouteNextNetworkInstanceOcUnsupported())
 }
 
 // GnpsiOcUnsupported returns true if there's no OC support for configuring gNPSI
 func GnpsiOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGnpsiOcUnsupported()
+	return recorded(dut.ID(), "gnpsi_oc_unsupported", lookupDUTDeviations(dut).GetGnpsiOcUnsupported())
 }
 
 // SyslogNonDefaultVrfUnsupported returns true if device does not support adding remote-syslog config under
 // non-default VRF
 func SyslogNonDefaultVrfUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSyslogNonDefaultVrfUnsupported()
+	return recorded(dut.ID(), "syslog_non_default_vrf_unsupported", lookupDUTDeviations(dut).GetSyslogNonDefaultVrfUnsupported())
 }
 
 // BgpLocalAggregateUnsupported returns true for devices that don't support OC configuration of BGP local aggregates
 func BgpLocalAggregateUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpLocalAggregateUnsupported()
+	return recorded(dut.ID(), "bgp_local_aggregate_unsupported", lookupDUTDeviations(dut).GetBgpLocalAggregateUnsupported())
 }
 
 // SkipSamplingQosCounters returns true if device does not support sampling QoS counters
 // Cisco: https://partnerissuetracker.corp.google.com/u/0/issues/463279843
 func SkipSamplingQosCounters(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipSamplingQosCounters()
+	return recorded(dut.ID(), "skip_sampling_qos_counters", lookupDUTDeviations(dut).GetSkipSamplingQosCounters())
 }
 
 // DefaultNiGnmiServerName returns the user provided default server name for gRPC server in the default network-instance.
 func DefaultNiGnmiServerName(dut *ondatra.DUTDevice) string {
-	if gnmiServerName := lookupDUTDeviations(dut).GetDefaultNiGnmiServerName(); gnmiServerName != "" {
+	if gnmiServerName := recorded(dut.ID(), "default_ni_gnmi_server_name", lookupDUTDeviations(dut).GetDefaultNiGnmiServerName()); gnmiServerName != "" {
 		return gnmiServerName
 	}
 	return "DEFAULT"
@@ -1805,17 +1823,17 @@ func DefaultNiGnmiServerName(dut *ondatra.DUTDevice) string {
 
 // ConfigACLWithPrefixListNotSupported returns true if configuring prefixlist in ACL not supported
 func ConfigACLWithPrefixListNotSupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetConfigAclWithPrefixlistUnsupported()
+	return recorded(dut.ID(), "config_acl_with_prefixlist_unsupported", lookupDUTDeviations(dut).GetConfigAclWithPrefixlistUnsupported())
 }
 
 // ConfigACLValueAnyOcUnsupported returns true if OC for configuring parameter in ACL with value ANY not supported
 func ConfigACLValueAnyOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetConfigAclValueAnyOcUnsupported()
+	return recorded(dut.ID(), "config_acl_value_any_oc_unsupported", lookupDUTDeviations(dut).GetConfigAclValueAnyOcUnsupported())
 }
 
 // ConfigACLOcUnsupported returns true if OC for configuring parameter in ACL with OC is not supported
 func ConfigACLOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetConfigAclOcUnsupported()
+	return recorded(dut.ID(), "config_acl_oc_unsupported", lookupDUTDeviations(dut).GetConfigAclOcUnsupported())
 }
 
 // BgpRibStreamingConfigRequired returns true for devices that require an
@@ -1825,432 +1843,430 @@ func ConfigACLOcUnsupported(dut *ondatra.DUTDevice) bool {
 //
 // Arista: https://partnerissuetracker.corp.google.com/issues/471971235
 func BgpRibStreamingConfigRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpRibStreamingConfigRequired()
+	return recorded(dut.ID(), "bgp_rib_streaming_config_required", lookupDUTDeviations(dut).GetBgpRibStreamingConfigRequired())
 }
 
 // InterfaceCountersInUnknownProtosUnsupported returns if the device does not support interface counters in unknown protos.
 // https://issuetracker.google.com/issues/461368936
 // Arista: https://issuetracker.google.com/456175795
 func InterfaceCountersInUnknownProtosUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceCountersInUnknownProtosUnsupported()
+	return recorded(dut.ID(), "interface_counters_in_unknown_protos_unsupported", lookupDUTDeviations(dut).GetInterfaceCountersInUnknownProtosUnsupported())
 }
 
 // AggregateSIDCounterOutPktsUnsupported returns true if device does not support
 // /network-instances/network-instance/mpls/signaling-protocols/segment-routing/aggregate-sid-counters/aggregate-sid-counter/state/out-pkts
 func AggregateSIDCounterOutPktsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAggregateSidCounterOutPktsUnsupported()
+	return recorded(dut.ID(), "aggregate_sid_counter_out_pkts_unsupported", lookupDUTDeviations(dut).GetAggregateSidCounterOutPktsUnsupported())
 }
 
 // MatchCommunitySetMatchSetOptionsAllUnsupported returns true if device does not support match-set-options=ALL
 // for bgp-conditions community-sets
 // Arista: b/335739231
 func MatchCommunitySetMatchSetOptionsAllUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMatchCommunitySetMatchSetOptionsAllUnsupported()
+	return recorded(dut.ID(), "match_community_set_match_set_options_all_unsupported", lookupDUTDeviations(dut).GetMatchCommunitySetMatchSetOptionsAllUnsupported())
 }
 
 // BMPOCUnsupported returns true if BMP configuration is not supported
 func BMPOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBmpOcUnsupported()
+	return recorded(dut.ID(), "bmp_oc_unsupported", lookupDUTDeviations(dut).GetBmpOcUnsupported())
 }
 
 // BgpCommunityTypeSliceInputUnsupported returns true if device does not support slice input of BGP community type
 // Cisco: https://partnerissuetracker.corp.google.com/u/0/issues/468284934
 func BgpCommunityTypeSliceInputUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpCommunityTypeSliceInputUnsupported()
+	return recorded(dut.ID(), "bgp_community_type_slice_input_unsupported", lookupDUTDeviations(dut).GetBgpCommunityTypeSliceInputUnsupported())
 }
 
 // IbgpMultipathPathUnsupported returns true if device does not support configuring multipath path under ibgp
 func IbgpMultipathPathUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIbgpMultipathPathUnsupported()
+	return recorded(dut.ID(), "ibgp_multipath_path_unsupported", lookupDUTDeviations(dut).GetIbgpMultipathPathUnsupported())
 }
 
 // GetRetainGnmiCfgAfterReboot returns true if the device requires additional configuration to retain gNMI config across reboots.
 // Arista: https://partnerissuetracker.corp.google.com/476271160
 func GetRetainGnmiCfgAfterReboot(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetRetainGnmiCfgAfterReboot()
+	return recorded(dut.ID(), "retain_gnmi_cfg_after_reboot", lookupDUTDeviations(dut).GetRetainGnmiCfgAfterReboot())
 }
 
 // ContainerzPluginRPCUnsupported returns true if ContainerZ plugin RPCs are unsupported.
 func ContainerzPluginRPCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetContainerzPluginRpcUnsupported()
+	return recorded(dut.ID(), "containerz_plugin_rpc_unsupported", lookupDUTDeviations(dut).GetContainerzPluginRpcUnsupported())
 }
 
 // NonStandardGRPCPort returns true if the device does not use standard grpc port.
 // Arista b/384040563
 func NonStandardGRPCPort(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNonStandardGrpcPort()
+	return recorded(dut.ID(), "non_standard_grpc_port", lookupDUTDeviations(dut).GetNonStandardGrpcPort())
 }
 
 // TemperatureSensorCheck returns true if the transceiver subcomponent should look for the temperature sensor
 func TemperatureSensorCheck(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTemperatureSensorCheck()
+	return recorded(dut.ID(), "temperature_sensor_check", lookupDUTDeviations(dut).GetTemperatureSensorCheck())
 }
 
 // CPUUtilizationQueryAgainstBaseControllerCardComponent returns true if the device reports Controller CPU utilization against the base controller card component
 // example: against "0/RP0/CPU0" and not "0/RP00/CPU0-Broadwell-DE (D-1573N)"
 func CPUUtilizationQueryAgainstBaseControllerCardComponent(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetCpuUtilizationQueryAgainstBaseControllerCardComponent()
+	return recorded(dut.ID(), "cpu_utilization_query_against_base_controller_card_component", lookupDUTDeviations(dut).GetCpuUtilizationQueryAgainstBaseControllerCardComponent())
 }
 
 // CPUUtilizationQueryAgainstBaseLinecardComponent returns true if the device reports linecard CPU utilization against the base linecard component
 // example: against "0/0/CPU0" and not "0/0/CPU0-Broadwell-DE (D-1573N)"
 func CPUUtilizationQueryAgainstBaseLinecardComponent(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetCpuUtilizationQueryAgainstBaseLinecardComponent()
+	return recorded(dut.ID(), "cpu_utilization_query_against_base_linecard_component", lookupDUTDeviations(dut).GetCpuUtilizationQueryAgainstBaseLinecardComponent())
 }
 
 // NoQueueDropUnsupported returns true if device does not support no-queue drops
 // Arista: https://issuetracker.google.com/456220916
 func NoQueueDropUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNoQueueDropUnsupported()
+	return recorded(dut.ID(), "no_queue_drop_unsupported", lookupDUTDeviations(dut).GetNoQueueDropUnsupported())
 }
 
 // InterfaceEthernetInblockErrorsUnsupported returns true if device does not support interface ethernet in-block errors
 // Arista: https://issuetracker.google.com/456175793
 func InterfaceEthernetInblockErrorsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceEthernetInblockErrorsUnsupported()
+	return recorded(dut.ID(), "interface_ethernet_inblock_errors_unsupported", lookupDUTDeviations(dut).GetInterfaceEthernetInblockErrorsUnsupported())
 }
 
-// WithIPAddressUnsupported returns true when an indirect next-hop (direct interface IP) with forwarding viable is used, since this is not supported.
+// ForwardingViableFailoverWithIndirectNHUnsupported returns true when an indirect next-hop (direct interface IP) with forwarding viable is used, since this is not supported.
 // Nokia b/428883444
 func ForwardingViableFailoverWithIndirectNHUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetForwardingViableFailoverWithIndirectNhUnsupported()
+	return recorded(dut.ID(), "forwarding_viable_failover_with_indirect_nh_unsupported", lookupDUTDeviations(dut).GetForwardingViableFailoverWithIndirectNhUnsupported())
 }
 
 // CiscoxrTransceiverFt returns the functional translator to be used for translating
 // transceiver threshold leaves.
 func CiscoxrTransceiverFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetCiscoxrTransceiverFt()
+	return recorded(dut.ID(), "ciscoxr_transceiver_ft", lookupDUTDeviations(dut).GetCiscoxrTransceiverFt())
 }
 
 // TransceiverStateUnsupported returns true if device does not support transceiver state leaf.
 func TransceiverStateUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTransceiverStateUnsupported()
+	return recorded(dut.ID(), "transceiver_state_unsupported", lookupDUTDeviations(dut).GetTransceiverStateUnsupported())
 }
 
 // SubnetMaskChangeRequired returns true if the device requires changing the subnet mask length.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/478070225
 func SubnetMaskChangeRequired(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSubnetMaskChangeRequired()
+	return recorded(dut.ID(), "subnet_mask_change_required", lookupDUTDeviations(dut).GetSubnetMaskChangeRequired())
 }
 
 // Ciscoxr8000IntegratedCircuitResourceFt returns the functional translator to be used for translating
 // integrated circuit resource leaves.
 func Ciscoxr8000IntegratedCircuitResourceFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetCiscoxr8000IntegratedCircuitResourceFt()
+	return recorded(dut.ID(), "ciscoxr8000_integrated_circuit_resource_ft", lookupDUTDeviations(dut).GetCiscoxr8000IntegratedCircuitResourceFt())
 }
 
 // CiscoxrVendordropFt returns the functional translator to be used for translating Cisco XR vendor drop counters.
 func CiscoxrVendordropFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetCiscoxrVendordropFt()
+	return recorded(dut.ID(), "ciscoxr_vendordrop_ft", lookupDUTDeviations(dut).GetCiscoxrVendordropFt())
 }
 
 // BgpDefaultPolicyBehaviorAcceptRoute returns true if the BGP accepts routes by default when
 // there is no routing policy or default policy configured.
 func BgpDefaultPolicyBehaviorAcceptRoute(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpDefaultPolicyBehaviorAcceptRoute()
+	return recorded(dut.ID(), "bgp_default_policy_behavior_accept_route", lookupDUTDeviations(dut).GetBgpDefaultPolicyBehaviorAcceptRoute())
 }
 
 // TerminalDeviceChannelAdminStateUnsupported returns true if setting admin-state on
 // TerminalDevice Channel is unsupported.
 // Arista: https://issuetracker.google.com/482191638
 func TerminalDeviceChannelAdminStateUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetTerminalDeviceChannelAdminStateUnsupported()
+	return recorded(dut.ID(), "terminal_device_channel_admin_state_unsupported", lookupDUTDeviations(dut).GetTerminalDeviceChannelAdminStateUnsupported())
 }
 
 // ACLCountersEnableOCUnsupported returns true if enabling ACL counters via OpenConfig is unsupported.
 // Arista: https://partnerissuetracker.corp.google.com/issues/485515097
 func ACLCountersEnableOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAclCountersEnableOcUnsupported()
+	return recorded(dut.ID(), "acl_counters_enable_oc_unsupported", lookupDUTDeviations(dut).GetAclCountersEnableOcUnsupported())
 }
 
 // SkipACLCountersVerificationDuringUpdate returns true if ACL counter verification should be skipped during ACL updates.
 // Arista: https://partnerissuetracker.corp.google.com/issues/465920254
 func SkipACLCountersVerificationDuringUpdate(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSkipAclCountersVerificationDuringUpdate()
+	return recorded(dut.ID(), "skip_acl_counters_verification_during_update", lookupDUTDeviations(dut).GetSkipAclCountersVerificationDuringUpdate())
 }
 
 // ACLIcmpTypeCodeConfigurationUnsupported returns true if device does not support configuring ICMP type and code fields for ACL.
 // Arista: https://partnerissuetracker.corp.google.com/issues/487324495
 func ACLIcmpTypeCodeConfigurationUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAclIcmpTypeCodeConfigurationUnsupported()
+	return recorded(dut.ID(), "acl_icmp_type_code_configuration_unsupported", lookupDUTDeviations(dut).GetAclIcmpTypeCodeConfigurationUnsupported())
 }
 
 // Ipv6RouterAdvertisementSuppressUnsupported returns true if devices do not support suppress router advertisement.
 func Ipv6RouterAdvertisementSuppressUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetIpv6RouterAdvertisementSuppressUnsupported()
+	return recorded(dut.ID(), "ipv6_router_advertisement_suppress_unsupported", lookupDUTDeviations(dut).GetIpv6RouterAdvertisementSuppressUnsupported())
 }
 
 // BgpConfigDuringGracefulRestartUnsupported returns true if the device does not support BGP configuration during graceful restart.
 // Nokia: https://partnerissuetracker.corp.google.com/issues/489255397
 func BgpConfigDuringGracefulRestartUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpConfigDuringGracefulRestartUnsupported()
+	return recorded(dut.ID(), "bgp_config_during_graceful_restart_unsupported", lookupDUTDeviations(dut).GetBgpConfigDuringGracefulRestartUnsupported())
 }
 
 // RoutingRestartViaGnoiUnsupported returns true if the device does not support restarting the routing process via gNOI.
 // Arista: https://partnerissuetracker.corp.google.com/issues/489304077
 func RoutingRestartViaGnoiUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetRoutingRestartViaGnoiUnsupported()
+	return recorded(dut.ID(), "routing_restart_via_gnoi_unsupported", lookupDUTDeviations(dut).GetRoutingRestartViaGnoiUnsupported())
 }
 
 // BgpRplDirectlyUnderPeerGroupUnsupported returns true if the device does not support BGP RPL under peer-group directly
 // Cisco: https://partnerissuetracker.corp.google.com/issues/490033220
 func BgpRplDirectlyUnderPeerGroupUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpRplDirectlyUnderPeerGroupUnsupported()
+	return recorded(dut.ID(), "bgp_rpl_directly_under_peer_group_unsupported", lookupDUTDeviations(dut).GetBgpRplDirectlyUnderPeerGroupUnsupported())
 }
 
 // WecmpSetWeightUnsupported returns if device doesnt support setting weight for wecmp.
 func WecmpSetWeightUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetWecmpSetWeightUnsupported()
+	return recorded(dut.ID(), "wecmp_set_weight_unsupported", lookupDUTDeviations(dut).GetWecmpSetWeightUnsupported())
 }
 
 // ExplicitlyApplyAllowAllImportPolicy returns true if we need to explicitly apply "allow-all" import policy on the device
 // Cisco: https://partnerissuetracker.corp.google.com/issues/479056256
 func ExplicitlyApplyAllowAllImportPolicy(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetExplicitlyApplyAllowAllImportPolicy()
+	return recorded(dut.ID(), "explicitly_apply_allow_all_import_policy", lookupDUTDeviations(dut).GetExplicitlyApplyAllowAllImportPolicy())
 }
 
 // QosFt returns the functional translator to be used for translating QoS leaves.
 func QosFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetQosFt()
+	return recorded(dut.ID(), "qos_ft", lookupDUTDeviations(dut).GetQosFt())
 }
 
 // SystemMountPointStateFt returns the functional translator name for devices with mount point state paths unsupported.
 func SystemMountPointStateFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetSystemMountPointStateFt()
+	return recorded(dut.ID(), "system_mount_point_state_ft", lookupDUTDeviations(dut).GetSystemMountPointStateFt())
 }
 
 // ArpFT returns the functional translator name for devices with neighbor link-layer-address paths unsupported.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/429137958
 func ArpFT(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetArpFt()
+	return recorded(dut.ID(), "arp_ft", lookupDUTDeviations(dut).GetArpFt())
 }
 
 // PrefixLimitConfigUnsupported returns true if max prefix limit configuration is unsupported by the device
 // Cisco: https://partnerissuetracker.corp.google.com/issues/447509237
 func PrefixLimitConfigUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPrefixLimitConfigUnsupported()
+	return recorded(dut.ID(), "prefix_limit_config_unsupported", lookupDUTDeviations(dut).GetPrefixLimitConfigUnsupported())
 }
 
 // SSHServerHostCertificateTelemetryUnsupported returns true if /system/ssh-server/state/active-host-certificate-version
 // is not supported.
 // Nokia: https://partnerissuetracker.corp.google.com/issues/494777653
 func SSHServerHostCertificateTelemetryUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSshServerHostCertificateTelemetryUnsupported()
+	return recorded(dut.ID(), "ssh_server_host_certificate_telemetry_unsupported", lookupDUTDeviations(dut).GetSshServerHostCertificateTelemetryUnsupported())
 }
 
 // SendMaxUnsupported returns true if the device does not support leaf send max.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/498283710
 func SendMaxUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSendMaxUnsupported()
+	return recorded(dut.ID(), "send_max_unsupported", lookupDUTDeviations(dut).GetSendMaxUnsupported())
 }
 
 // OcAaaUserRoleLeafStringTypeUnsupported returns true if the device does not support role leaf of string type for OC system/aaa username configuration.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/436778949
 func OcAaaUserRoleLeafStringTypeUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetOcAaaUserRoleLeafStringTypeUnsupported()
+	return recorded(dut.ID(), "oc_aaa_user_role_leaf_string_type_unsupported", lookupDUTDeviations(dut).GetOcAaaUserRoleLeafStringTypeUnsupported())
 }
 
 // AcctzShellCmdAccountingUnsupported returns true if the device does not support shell cmd accounting records.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/436778949
 func AcctzShellCmdAccountingUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAcctzShellCmdAccountingUnsupported()
+	return recorded(dut.ID(), "acctz_shell_cmd_accounting_unsupported", lookupDUTDeviations(dut).GetAcctzShellCmdAccountingUnsupported())
 }
 
 // AcctzRecordsAuthzStatusDenyUnsupported returns true if the device does not support AuthZ status field with 'Deny' in CMD service in AcctZ records.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/436778949
 func AcctzRecordsAuthzStatusDenyUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAcctzRecordsAuthzStatusDenyUnsupported()
+	return recorded(dut.ID(), "acctz_records_authz_status_deny_unsupported", lookupDUTDeviations(dut).GetAcctzRecordsAuthzStatusDenyUnsupported())
 }
 
 // FpgaFt returns the functional translator name for devices with FPD paths unsupported.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/429156503
 func FpgaFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetFpgaFt()
+	return recorded(dut.ID(), "fpga_ft", lookupDUTDeviations(dut).GetFpgaFt())
 }
 
-// AcctzRecordFailCommandUnsupported  returns true if the device does not support Acctz record for fail user
+// AcctzRecordFailCommandUnsupported returns true if the device does not support Acctz record for fail user
 // Juniper: https://partnerissuetracker.corp.google.com/issues/500649430
-
 func AcctzRecordFailCommandUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAcctzRecordFailCommandUnsupported()
+	return recorded(dut.ID(), "acctz_record_fail_command_unsupported", lookupDUTDeviations(dut).GetAcctzRecordFailCommandUnsupported())
 }
 
-// AcctzRecordFailCommandUnsupported  returns true if the device does not support Acctz record for fail user
+// AcctzRecordFailGrpcUnsupported returns true if the device does not support Acctz record for fail user
 // Juniper: https://partnerissuetracker.corp.google.com/issues/500627000
-
 func AcctzRecordFailGrpcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAcctzRecordFailGrpcUnsupported()
+	return recorded(dut.ID(), "acctz_record_fail_grpc_unsupported", lookupDUTDeviations(dut).GetAcctzRecordFailGrpcUnsupported())
 }
 
 // BgpGracefulRestartPeerGroupUnsupported returns true for devices that do not support BGP Graceful restart for Peer Group
 // Cisco: https://partnerissuetracker.corp.google.com/issues/468284935
 func BgpGracefulRestartPeerGroupUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpGracefulRestartPeerGroupUnsupported()
+	return recorded(dut.ID(), "bgp_graceful_restart_peer_group_unsupported", lookupDUTDeviations(dut).GetBgpGracefulRestartPeerGroupUnsupported())
 }
 
 // GrpcServerServicesUnsupported returns true if the device does not support the services leaf
 // under grpc-server config (/system/grpc-servers/grpc-server/config/services).
 // Arista: https://partnerissuetracker.corp.google.com/issues/500747414
 func GrpcServerServicesUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGrpcServerServicesUnsupported()
+	return recorded(dut.ID(), "grpc_server_services_unsupported", lookupDUTDeviations(dut).GetGrpcServerServicesUnsupported())
 }
 
-// StaticRouteToNextHopGroupOCNotSupported returns true if device does not support oc state path static route to nexthop group
+// StaticRouteToNHGOCUnsupported returns true if device does not support oc state path static route to nexthop group
 func StaticRouteToNHGOCUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStaticRouteToNhgOcUnsupported()
+	return recorded(dut.ID(), "static_route_to_nhg_oc_unsupported", lookupDUTDeviations(dut).GetStaticRouteToNhgOcUnsupported())
 }
 
 // Subinterface0StateUnsupported returns true if the device does not populate
 // state on subinterface 0 that is implicitly created.
 func Subinterface0StateUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSubinterface_0StateUnsupported()
+	return recorded(dut.ID(), "subinterface_0_state_unsupported", lookupDUTDeviations(dut).GetSubinterface_0StateUnsupported())
 }
 
 // FragmentPuntUnsupported returns true if the device does not support fragment punt drops.
 func FragmentPuntUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetFragmentPuntUnsupported()
+	return recorded(dut.ID(), "fragment_punt_unsupported", lookupDUTDeviations(dut).GetFragmentPuntUnsupported())
 }
 
 // FragmentPuntPktsUnsupported returns true if the device does not support fragment punt pkts.
 func FragmentPuntPktsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetFragmentPuntPktsUnsupported()
+	return recorded(dut.ID(), "fragment_punt_pkts_unsupported", lookupDUTDeviations(dut).GetFragmentPuntPktsUnsupported())
 }
 
 // FragmentPuntFt returns the functional translator to be used for translating Fragment Punt OC paths.
 func FragmentPuntFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetFragmentPuntFt()
+	return recorded(dut.ID(), "fragment_punt_ft", lookupDUTDeviations(dut).GetFragmentPuntFt())
 }
 
 // AcctzRecordSessionChannelIdUnsupported returns true if the device does not support Acctz record for fail user
 // Juniper: https://partnerissuetracker.corp.google.com/issues/500627000
 func AcctzRecordSessionChannelIdUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetAcctzRecordSessionChannelIdUnsupported()
+	return recorded(dut.ID(), "acctz_record_session_channel_id_unsupported", lookupDUTDeviations(dut).GetAcctzRecordSessionChannelIdUnsupported())
 }
 
 // CarrierFt returns the functional translator to be used for translating
 // phy-carrier-transitions path.
 func CarrierFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetCarrierFt()
+	return recorded(dut.ID(), "carrier_ft", lookupDUTDeviations(dut).GetCarrierFt())
 }
 
 // FabricFt returns the functional translator name for fabric error telemetry.
 // Cisco: https://partnerissuetracker.corp.google.com/issues/429166378
 func FabricFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetFabricFt()
+	return recorded(dut.ID(), "fabric_ft", lookupDUTDeviations(dut).GetFabricFt())
 }
 
 // MacsecStateFt returns the functional translator name for macsec state telemetry.
 func MacsecStateFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetMacsecStateFt()
+	return recorded(dut.ID(), "macsec_state_ft", lookupDUTDeviations(dut).GetMacsecStateFt())
 }
 
 // MacsecCountersFt returns the functional translator name for macsec counters telemetry.
 func MacsecCountersFt(dut *ondatra.DUTDevice) string {
-	return lookupDUTDeviations(dut).GetMacsecCountersFt()
+	return recorded(dut.ID(), "macsec_counters_ft", lookupDUTDeviations(dut).GetMacsecCountersFt())
 }
 
 // EnableMplsStaticOnInterface returns true if device needs MPLS Static enabled explicitly on ingress/egress interface
 func EnableMplsStaticOnInterface(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetEnableMplsStaticOnInterface()
+	return recorded(dut.ID(), "enable_mpls_static_on_interface", lookupDUTDeviations(dut).GetEnableMplsStaticOnInterface())
 }
 
-// Device does not support secondary controller card CPU utilization
+// SecondaryControllerCardCpuUtilizationUnsupported returns true if the device does not support secondary controller card CPU utilization
 // Arista: https://issuetracker.google.com/issues/508666262
 func SecondaryControllerCardCpuUtilizationUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSecondaryControllerCardCpuUtilizationUnsupported()
+	return recorded(dut.ID(), "secondary_controller_card_cpu_utilization_unsupported", lookupDUTDeviations(dut).GetSecondaryControllerCardCpuUtilizationUnsupported())
 }
 
-// Device does not support secondary controller card memory utilization
+// SecondaryControllerCardMemoryUtilizationUnsupported returns true if the device does not support secondary controller card memory utilization
 // Arista: https://issuetracker.google.com/issues/508656197
 func SecondaryControllerCardMemoryUtilizationUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetSecondaryControllerCardMemoryUtilizationUnsupported()
+	return recorded(dut.ID(), "secondary_controller_card_memory_utilization_unsupported", lookupDUTDeviations(dut).GetSecondaryControllerCardMemoryUtilizationUnsupported())
 }
 
-// Device does not support interface counters in fcs errors
+// InterfaceCountersInFcsErrorsUnsupported returns true if the device does not support interface counters in fcs errors
 // Arista: https://issuetracker.google.com/issues/508304903
 func InterfaceCountersInFcsErrorsUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceCountersInFcsErrorsUnsupported()
+	return recorded(dut.ID(), "interface_counters_in_fcs_errors_unsupported", lookupDUTDeviations(dut).GetInterfaceCountersInFcsErrorsUnsupported())
 }
 
-// Mpls static pseudowire returns true if oc is not supported
+// MplsStaticPseudowireOcUnsupported returns true if oc is not supported for mpls static pseudowire
 func MplsStaticPseudowireOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetMplsStaticPseudowireOcUnsupported()
+	return recorded(dut.ID(), "mpls_static_pseudowire_oc_unsupported", lookupDUTDeviations(dut).GetMplsStaticPseudowireOcUnsupported())
 }
 
-// Vlan client encapsulation returns true if oc is not supported
+// VlanClientEncapsulationOcUnsupported returns true if oc is not supported for vlan client encapsulation
 func VlanClientEncapsulationOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetVlanClientEncapsulationOcUnsupported()
+	return recorded(dut.ID(), "vlan_client_encapsulation_oc_unsupported", lookupDUTDeviations(dut).GetVlanClientEncapsulationOcUnsupported())
 }
 
 // NexthopGroupPseudowireCountersOcUnsupported returns true if oc is not supported
 func NexthopGroupPseudowireCountersOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetNexthopGroupPseudowireCountersOcUnsupported()
+	return recorded(dut.ID(), "nexthop_group_pseudowire_counters_oc_unsupported", lookupDUTDeviations(dut).GetNexthopGroupPseudowireCountersOcUnsupported())
 }
 
 // PerFlowLoadBalancingUnsupported returns true if the device does not support BGP max multipath paths.
 func PerFlowLoadBalancingUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetPerFlowLoadBalancingUnsupported()
+	return recorded(dut.ID(), "per_flow_load_balancing_unsupported", lookupDUTDeviations(dut).GetPerFlowLoadBalancingUnsupported())
 }
 
 // BgpMultipathPathsUnderPeerGroupUnsupported returns true if the device does not support BGP multipath paths under peer group
 func BgpMultipathPathsUnderPeerGroupUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetBgpMultipathPathsUnderPeerGroupUnsupported()
+	return recorded(dut.ID(), "bgp_multipath_paths_under_peer_group_unsupported", lookupDUTDeviations(dut).GetBgpMultipathPathsUnderPeerGroupUnsupported())
 }
 
 // LACPInterfaceMemberStateInterfaceUnsupported returns true if the device does not support
 // /lacp/interfaces/interface/members/member/state/interface.
 // Nokia: https://issuetracker.google.com/514181497
 func LACPInterfaceMemberStateInterfaceUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetLacpInterfaceMemberStateInterfaceUnsupported()
+	return recorded(dut.ID(), "lacp_interface_member_state_interface_unsupported", lookupDUTDeviations(dut).GetLacpInterfaceMemberStateInterfaceUnsupported())
 }
 
 // RequireTransportSecurity returns if device requires transport-security to be enabled.
 // Juniper: https://partnerissuetracker.corp.google.com/issues/515276334
 func RequireTransportSecurity(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetRequireTransportSecurity()
+	return recorded(dut.ID(), "require_transport_security", lookupDUTDeviations(dut).GetRequireTransportSecurity())
 }
 
 // ExtendedRouteRetentionOcUnsupported returns true if devices do not support extended Route Retention.
 // Use the deviation if BGP Extension Route Retention configuration is not available via OC
 func ExtendedRouteRetentionOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetExtendedRouteRetentionOcUnsupported()
+	return recorded(dut.ID(), "extended_route_retention_oc_unsupported", lookupDUTDeviations(dut).GetExtendedRouteRetentionOcUnsupported())
 }
 
 // ExrrStaleRouteTimeUnsupported returns true if devices do not support exrr stale route time configuration.
 // Use the deviation if BGP Stale Route Time is not supported by DUT
 func ExrrStaleRouteTimeUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetExrrStaleRouteTimeUnsupported()
+	return recorded(dut.ID(), "exrr_stale_route_time_unsupported", lookupDUTDeviations(dut).GetExrrStaleRouteTimeUnsupported())
 }
 
 // GnoiBgpGracefulRestartUnsupported returns true if gNMI/gNOI support for BGP graceful restart is not available.
 // Use the deviation if BGP Graceful restart is not supported using gnoi
 func GnoiBgpGracefulRestartUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetGnoiBgpGracefulRestartUnsupported()
+	return recorded(dut.ID(), "gnoi_bgp_graceful_restart_unsupported", lookupDUTDeviations(dut).GetGnoiBgpGracefulRestartUnsupported())
 }
 
 // DhcpRelayOcUnsupported returns true if DHCP relay configuration and state paths are unsupported.
 // Arista: https://partnerissuetracker.corp.google.com/issues/497757203
 func DhcpRelayOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetDhcpRelayOcUnsupported()
+	return recorded(dut.ID(), "dhcp_relay_oc_unsupported", lookupDUTDeviations(dut).GetDhcpRelayOcUnsupported())
 }
 
 // P4RTExplicitTableEntryPerController returns true if the DUT requires p4rt table entries to be configured for each new primary controller
 // Nokia: b/445494680
 func P4RTExplicitTableEntryPerController(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetP4RtExplicitTableEntryPerController()
+	return recorded(dut.ID(), "p4rt_explicit_table_entry_per_controller", lookupDUTDeviations(dut).GetP4RtExplicitTableEntryPerController())
 }
 
 // UseInterfaceNameForIBGPNeighborTransportIpv4LocalAddress returns true if the device needs a LocalAddress that points
 // to an interface name instead of an IPv4 address for establishing BGP neighborship.
 // Cisco: https://partnerissuetracker.corp.google.com/u/0/issues/500609711
 func UseInterfaceNameForIBGPNeighborTransportIpv4LocalAddress(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetUseInterfaceNameForIbgpNeighborTransportIpv4LocalAddress()
+	return recorded(dut.ID(), "use_interface_name_for_ibgp_neighbor_transport_ipv4_local_address", lookupDUTDeviations(dut).GetUseInterfaceNameForIbgpNeighborTransportIpv4LocalAddress())
 }
 
 // InterfaceIDFormatRequiredForPolicyForwarding returns if device requires policy-forwarding interface keys to use interface name + .subinterface index.
 // Cisco: https://partnerissuetracker.corp.google.com/u/0/issues/523054650
 func InterfaceIDFormatRequiredForPolicyForwarding(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetInterfaceIdFormatRequiredForPolicyForwarding()
+	return recorded(dut.ID(), "interface_id_format_required_for_policy_forwarding", lookupDUTDeviations(dut).GetInterfaceIdFormatRequiredForPolicyForwarding())
 }
 
 // UseChassisAggregateUtilization returns true for devices that report resource
@@ -2258,16 +2274,22 @@ func InterfaceIDFormatRequiredForPolicyForwarding(dut *ondatra.DUTDevice) bool {
 // integrated-circuit component level.
 // Arista: https://partnerissuetracker.corp.google.com/issues/523026741
 func UseChassisAggregateUtilization(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetUseChassisAggregateUtilization()
+	return recorded(dut.ID(), "use_chassis_aggregate_utilization", lookupDUTDeviations(dut).GetUseChassisAggregateUtilization())
 }
 
 // UnreferencedAftFibAckUnsupported returns true if no FIB_ACK for unreferenced NH/NHG entries
 func UnreferencedAftFibAckUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetUnreferencedAftFibAckUnsupported()
+	return recorded(dut.ID(), "unreferenced_aft_fib_ack_unsupported", lookupDUTDeviations(dut).GetUnreferencedAftFibAckUnsupported())
 }
 
 // StaticRouteNexthopInterfaceStateOcUnsupported returns true if the device does not support state for static route next-hop interface.
 // Arista: b/494493377
 func StaticRouteNexthopInterfaceStateOcUnsupported(dut *ondatra.DUTDevice) bool {
-	return lookupDUTDeviations(dut).GetStaticRouteNexthopInterfaceStateOcUnsupported()
+	return recorded(dut.ID(), "static_route_nexthop_interface_state_oc_unsupported", lookupDUTDeviations(dut).GetStaticRouteNexthopInterfaceStateOcUnsupported())
+}
+
+// TracerouteFragmentation returns the traceroute_fragmentation deviation.
+// Device does not support fragmentation bit for traceroute.
+func TracerouteFragmentation(dut *ondatra.DUTDevice) bool {
+	return recorded(dut.ID(), "traceroute_fragmentation", lookupDUTDeviations(dut).GetTracerouteFragmentation())
 }
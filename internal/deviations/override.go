@@ -0,0 +1,281 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "github.com/golang/glog"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+var deviationsOverride = flag.String("deviations_override", "", "Deviations to apply on top of the metadata.textproto match, either a file containing a textproto Deviations message, or a comma separated list of name=value pairs where a name may be prefixed with a device ID and a colon to apply to that device only, e.g. omit_l2_mtu=true,dut2:default_network_instance=default.  Every deviation named is set exactly to its value, so false or 0 disables a matched deviation and a repeated deviation in a file replaces the matched list.")
+
+// override sets a deviation to a value.
+type override struct {
+	fd protoreflect.FieldDescriptor
+	v  protoreflect.Value
+}
+
+// overrides holds the parsed -deviations_override flag.
+type overrides struct {
+	// byScope maps device ID, or "" for all devices, to the deviations set for it.  The
+	// overrides for a device are applied on top of those for all devices.  A deviation
+	// is set at most once per scope; the last name=value pair for it wins.
+	byScope map[string][]override
+}
+
+var (
+	overridesOnce   sync.Once
+	parsedOverrides *overrides
+
+	overridesMu sync.Mutex
+	// appliedOverrides maps device ID to the overridden deviation values.
+	appliedOverrides = map[string]map[string]string{}
+)
+
+// mustOverrides returns the parsed -deviations_override flag, exiting if it is invalid.
+func mustOverrides() *overrides {
+	overridesOnce.Do(func() {
+		o, err := parseOverrides(*deviationsOverride)
+		if err != nil {
+			log.Exitf("Invalid -deviations_override: %v", err)
+		}
+		parsedOverrides = o
+	})
+	return parsedOverrides
+}
+
+// parseOverrides parses the value of the -deviations_override flag.  A value is treated
+// as a file if it names an existing file.
+func parseOverrides(value string) (*overrides, error) {
+	o := &overrides{byScope: map[string][]override{}}
+	if value == "" {
+		return o, nil
+	}
+	if _, err := os.Stat(value); err == nil {
+		bytes, err := os.ReadFile(value)
+		if err != nil {
+			return nil, err
+		}
+		all, err := parseOverridesFile(bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", value, err)
+		}
+		if len(all) > 0 {
+			o.byScope[""] = all
+		}
+		return o, nil
+	}
+
+	fds := (&mpb.Metadata_Deviations{}).ProtoReflect().Descriptor().Fields()
+	for _, pair := range strings.Split(value, ",") {
+		name, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not a name=value pair", pair)
+		}
+		device := ""
+		if d, n, ok := strings.Cut(name, ":"); ok {
+			device, name = d, n
+		}
+		fd := fds.ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("unknown deviation %q", name)
+		}
+		v, err := parseValue(fd, val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for deviation %q: %w", name, err)
+		}
+		scope := slices.DeleteFunc(o.byScope[device], func(ov override) bool { return ov.fd == fd })
+		o.byScope[device] = append(scope, override{fd: fd, v: v})
+	}
+	return o, nil
+}
+
+// presenceDeviations returns a copy of the Deviations descriptor in a proto2 file, so
+// that its singular fields have explicit presence and a textproto file can set a
+// deviation to false or 0.
+var presenceDeviations = sync.OnceValues(func() (protoreflect.MessageDescriptor, error) {
+	md := (&mpb.Metadata_Deviations{}).ProtoReflect().Descriptor()
+	dp := protodesc.ToDescriptorProto(md)
+	dp.OneofDecl = nil
+	for _, f := range dp.GetField() {
+		f.Proto3Optional = nil
+		f.OneofIndex = nil
+		f.Options = nil
+	}
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("deviations_override.proto"),
+		Package:     proto.String("deviations_override"),
+		Syntax:      proto.String("proto2"),
+		Dependency:  []string{md.ParentFile().Path()},
+		MessageType: []*descriptorpb.DescriptorProto{dp},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		return nil, err
+	}
+	return fd.Messages().Get(0), nil
+})
+
+// parseOverridesFile parses a textproto Deviations message, returning every deviation
+// present in the text, including those set to their default value.
+func parseOverridesFile(bytes []byte) ([]override, error) {
+	desc, err := presenceDeviations()
+	if err != nil {
+		return nil, err
+	}
+	m := dynamicpb.NewMessage(desc)
+	if err := prototext.Unmarshal(bytes, m); err != nil {
+		return nil, err
+	}
+	tmpl := (&mpb.Metadata_Deviations{}).ProtoReflect()
+	var ovs []override
+	m.Range(func(dfd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fd := tmpl.Descriptor().Fields().ByNumber(dfd.Number())
+		if fd.IsList() {
+			l := tmpl.NewField(fd).List()
+			for i := 0; i < v.List().Len(); i++ {
+				l.Append(v.List().Get(i))
+			}
+			v = protoreflect.ValueOfList(l)
+		}
+		ovs = append(ovs, override{fd: fd, v: v})
+		return true
+	})
+	sort.Slice(ovs, func(i, j int) bool { return ovs[i].fd.Number() < ovs[j].fd.Number() })
+	return ovs, nil
+}
+
+// parseValue parses a scalar deviation value.
+func parseValue(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	if fd.IsList() {
+		return protoreflect.Value{}, fmt.Errorf("repeated deviations are only supported in a textproto file")
+	}
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(strings.Trim(s, `"`)), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, err := strconv.ParseInt(s, 0, 32)
+		return protoreflect.ValueOfInt32(int32(i)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, err := strconv.ParseInt(s, 0, 64)
+		return protoreflect.ValueOfInt64(i), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		u, err := strconv.ParseUint(s, 0, 32)
+		return protoreflect.ValueOfUint32(uint32(u)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		u, err := strconv.ParseUint(s, 0, 64)
+		return protoreflect.ValueOfUint64(u), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		return protoreflect.Value{}, fmt.Errorf("unknown enum value %q", s)
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported kind %v", fd.Kind())
+}
+
+// apply returns a copy of the deviations matched for a device with the overrides set
+// on top, and the overridden values keyed by deviation name.  The overrides for all
+// devices are applied first, so those for the device take precedence.
+func (o *overrides) apply(device string, d *mpb.Metadata_Deviations) (*mpb.Metadata_Deviations, map[string]string) {
+	applied := map[string]string{}
+	if len(o.byScope) == 0 {
+		return d, applied
+	}
+	merged := proto.Clone(d).(*mpb.Metadata_Deviations)
+	m := merged.ProtoReflect()
+	scopes := []string{""}
+	if device != "" {
+		scopes = append(scopes, device)
+	}
+	for _, key := range scopes {
+		for _, ov := range o.byScope[key] {
+			if !ov.fd.IsList() {
+				// Setting the default value of a field without presence clears it.
+				m.Set(ov.fd, ov.v)
+				applied[string(ov.fd.Name())] = fmt.Sprint(ov.v.Interface())
+				continue
+			}
+			m.Clear(ov.fd)
+			var elems []string
+			for i := 0; i < ov.v.List().Len(); i++ {
+				m.Mutable(ov.fd).List().Append(ov.v.List().Get(i))
+				elems = append(elems, fmt.Sprint(ov.v.List().Get(i).Interface()))
+			}
+			applied[string(ov.fd.Name())] = "[" + strings.Join(elems, ",") + "]"
+		}
+	}
+	return merged, applied
+}
+
+// applyOverrides applies the -deviations_override flag to the deviations matched for a
+// device, and logs the overrides the first time they are applied to the device.
+func applyOverrides(device string, d *mpb.Metadata_Deviations) *mpb.Metadata_Deviations {
+	merged, applied := mustOverrides().apply(device, d)
+	if len(applied) == 0 {
+		return merged
+	}
+	overridesMu.Lock()
+	defer overridesMu.Unlock()
+	if _, ok := appliedOverrides[device]; !ok {
+		appliedOverrides[device] = applied
+		names := make([]string, 0, len(applied))
+		for name, value := range applied {
+			names = append(names, name+"="+value)
+		}
+		sort.Strings(names)
+		log.Infof("Overriding deviations of %s: %s", device, strings.Join(names, ", "))
+	}
+	return merged
+}
+
+// overrideProperties returns the applied overrides as suite properties, keyed by
+// "deviation_override.<device>.<name>".
+func overrideProperties() map[string]string {
+	overridesMu.Lock()
+	defer overridesMu.Unlock()
+	m := map[string]string{}
+	for device, applied := range appliedOverrides {
+		for name, value := range applied {
+			m[fmt.Sprintf("deviation_override.%s.%s", device, name)] = value
+		}
+	}
+	return m
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+func TestOverridesPairs(t *testing.T) {
+	o, err := parseOverrides("omit_l2_mtu=true, interface_enabled=false, dut2:default_network_instance=default, hierarchical_weight_resolution_tolerance=0.5")
+	if err != nil {
+		t.Fatalf("parseOverrides() got error: %v", err)
+	}
+	matched := &mpb.Metadata_Deviations{
+		InterfaceEnabled:     true,
+		SwVersionUnsupported: true,
+		StaticProtocolName:   "STATIC",
+	}
+
+	got, applied := o.apply("dut1", matched)
+	want := &mpb.Metadata_Deviations{
+		OmitL2Mtu:                             true,
+		SwVersionUnsupported:                  true,
+		StaticProtocolName:                    "STATIC",
+		HierarchicalWeightResolutionTolerance: 0.5,
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("apply(dut1) -want,+got:\n%s", diff)
+	}
+	wantApplied := map[string]string{
+		"omit_l2_mtu":       "true",
+		"interface_enabled": "false",
+		"hierarchical_weight_resolution_tolerance": "0.5",
+	}
+	if diff := cmp.Diff(wantApplied, applied); diff != "" {
+		t.Errorf("apply(dut1) applied -want,+got:\n%s", diff)
+	}
+
+	got, _ = o.apply("dut2", matched)
+	if got.GetDefaultNetworkInstance() != "default" {
+		t.Errorf("apply(dut2) default_network_instance got %q, want %q", got.GetDefaultNetworkInstance(), "default")
+	}
+	if !matched.GetInterfaceEnabled() {
+		t.Errorf("apply() modified the matched deviations")
+	}
+}
+
+func TestOverridesMixed(t *testing.T) {
+	cases := []struct {
+		desc        string
+		value       string
+		matched     *mpb.Metadata_Deviations
+		want        *mpb.Metadata_Deviations
+		wantApplied map[string]string
+	}{{
+		desc:        "device set after global clear",
+		value:       "omit_l2_mtu=false,dut2:omit_l2_mtu=true",
+		matched:     &mpb.Metadata_Deviations{OmitL2Mtu: true},
+		want:        &mpb.Metadata_Deviations{OmitL2Mtu: true},
+		wantApplied: map[string]string{"omit_l2_mtu": "true"},
+	}, {
+		desc:        "device clear after global set",
+		value:       "omit_l2_mtu=true,dut2:omit_l2_mtu=false",
+		want:        &mpb.Metadata_Deviations{},
+		wantApplied: map[string]string{"omit_l2_mtu": "false"},
+	}, {
+		desc:        "global set after global clear",
+		value:       "omit_l2_mtu=false,omit_l2_mtu=true",
+		want:        &mpb.Metadata_Deviations{OmitL2Mtu: true},
+		wantApplied: map[string]string{"omit_l2_mtu": "true"},
+	}, {
+		desc:        "global clear after global set",
+		value:       "omit_l2_mtu=true,omit_l2_mtu=false",
+		matched:     &mpb.Metadata_Deviations{OmitL2Mtu: true},
+		want:        &mpb.Metadata_Deviations{},
+		wantApplied: map[string]string{"omit_l2_mtu": "false"},
+	}, {
+		desc:        "device set after device clear",
+		value:       "dut2:omit_l2_mtu=false,dut2:omit_l2_mtu=true",
+		want:        &mpb.Metadata_Deviations{OmitL2Mtu: true},
+		wantApplied: map[string]string{"omit_l2_mtu": "true"},
+	}, {
+		desc:    "other device",
+		value:   "omit_l2_mtu=false,dut1:omit_l2_mtu=true,dut2:static_protocol_name=STATIC",
+		matched: &mpb.Metadata_Deviations{OmitL2Mtu: true},
+		want:    &mpb.Metadata_Deviations{StaticProtocolName: "STATIC"},
+		wantApplied: map[string]string{
+			"omit_l2_mtu":          "false",
+			"static_protocol_name": "STATIC",
+		},
+	}}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			o, err := parseOverrides(c.value)
+			if err != nil {
+				t.Fatalf("parseOverrides(%q) got error: %v", c.value, err)
+			}
+			matched := c.matched
+			if matched == nil {
+				matched = &mpb.Metadata_Deviations{}
+			}
+			got, applied := o.apply("dut2", matched)
+			if diff := cmp.Diff(c.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("apply(dut2) -want,+got:\n%s", diff)
+			}
+			if diff := cmp.Diff(c.wantApplied, applied); diff != "" {
+				t.Errorf("apply(dut2) applied -want,+got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOverridesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "override.textproto")
+	if err := os.WriteFile(path, []byte("omit_l2_mtu: true\nbanner_delimiter: \"!\"\ninterface_enabled: false\nhierarchical_weight_resolution_tolerance: 0\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	o, err := parseOverrides(path)
+	if err != nil {
+		t.Fatalf("parseOverrides() got error: %v", err)
+	}
+	matched := &mpb.Metadata_Deviations{
+		InterfaceEnabled:                      true,
+		SwVersionUnsupported:                  true,
+		HierarchicalWeightResolutionTolerance: 0.5,
+	}
+	got, applied := o.apply("dut", matched)
+	want := &mpb.Metadata_Deviations{OmitL2Mtu: true, BannerDelimiter: "!", SwVersionUnsupported: true}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("apply() -want,+got:\n%s", diff)
+	}
+	wantApplied := map[string]string{
+		"omit_l2_mtu":       "true",
+		"banner_delimiter":  "!",
+		"interface_enabled": "false",
+		"hierarchical_weight_resolution_tolerance": "0",
+	}
+	if diff := cmp.Diff(wantApplied, applied); diff != "" {
+		t.Errorf("apply() applied -want,+got:\n%s", diff)
+	}
+}
+
+func TestOverridesErrors(t *testing.T) {
+	for _, value := range []string{
+		"omit_l2_mtu",
+		"no_such_deviation=true",
+		"omit_l2_mtu=maybe",
+	} {
+		if _, err := parseOverrides(value); err == nil {
+			t.Errorf("parseOverrides(%q) got nil error, want error", value)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// Consultation describes a deviation that was consulted during the test run.
+type Consultation struct {
+	// Name is the deviation field name in metadata.proto, e.g. "omit_l2_mtu".
+	Name string
+	// Device is the ID of the device in the testbed.
+	Device string
+	// Value is the deviation value as configured for the device.
+	Value any
+}
+
+// IsDefault returns true if the deviation value is the proto default, which means the
+// test did not rely on the deviation.
+func (c *Consultation) IsDefault() bool {
+	return c.Value == nil || reflect.ValueOf(c.Value).IsZero()
+}
+
+type consultKey struct {
+	name, device string
+}
+
+var (
+	consultMu sync.Mutex
+	consulted = map[consultKey]*Consultation{}
+)
+
+// recorded records that the named deviation was consulted for the device, and returns
+// the value unchanged.  Accessors must pass the value from metadata.textproto (or the
+// override flag) before applying any accessor specific default.
+func recorded[T any](device, name string, value T) T {
+	consultMu.Lock()
+	defer consultMu.Unlock()
+	consulted[consultKey{name: name, device: device}] = &Consultation{
+		Name:   name,
+		Device: device,
+		Value:  value,
+	}
+	return value
+}
+
+// Consulted returns the deviations consulted so far in the test run, sorted by device
+// and name.
+func Consulted() []*Consultation {
+	consultMu.Lock()
+	defer consultMu.Unlock()
+	var cs []*Consultation
+	for _, c := range consulted {
+		cs = append(cs, c)
+	}
+	sort.Slice(cs, func(i, j int) bool {
+		if cs[i].Device != cs[j].Device {
+			return cs[i].Device < cs[j].Device
+		}
+		return cs[i].Name < cs[j].Name
+	})
+	return cs
+}
+
+// Properties returns the consulted deviations with non-default values as suite
+// properties, keyed by "deviation.<device>.<name>".  A test run that reports none of
+// these properties passed without deviation (Tier 1).  Deviations overridden by the
+// -deviations_override flag are also reported, keyed by
+// "deviation_override.<device>.<name>".
+func Properties() map[string]string {
+	m := overrideProperties()
+	for _, c := range Consulted() {
+		if c.IsDefault() {
+			continue
+		}
+		m[fmt.Sprintf("deviation.%s.%s", c.Device, c.Name)] = fmt.Sprint(c.Value)
+	}
+	return m
+}
+
+// resetConsulted clears the consulted deviations; used by tests.
+func resetConsulted() {
+	consultMu.Lock()
+	defer consultMu.Unlock()
+	consulted = map[consultKey]*Consultation{}
+}
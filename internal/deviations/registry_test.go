@@ -0,0 +1,47 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProperties(t *testing.T) {
+	resetConsulted()
+	defer resetConsulted()
+
+	if got := recorded("dut", "omit_l2_mtu", true); !got {
+		t.Errorf("recorded() got %v, want true", got)
+	}
+	recorded("dut", "interface_enabled", false)
+	recorded("dut2", "default_network_instance", "")
+	recorded("dut2", "hierarchical_weight_resolution_tolerance", 0.5)
+	recorded("ate", "ate_ipv6_flow_label_unsupported", true)
+
+	want := map[string]string{
+		"deviation.ate.ate_ipv6_flow_label_unsupported":           "true",
+		"deviation.dut.omit_l2_mtu":                               "true",
+		"deviation.dut2.hierarchical_weight_resolution_tolerance": "0.5",
+	}
+	if diff := cmp.Diff(want, Properties()); diff != "" {
+		t.Errorf("Properties() -want,+got:\n%s", diff)
+	}
+
+	if got, want := len(Consulted()), 5; got != want {
+		t.Errorf("Consulted() got %d consultations, want %d", got, want)
+	}
+}
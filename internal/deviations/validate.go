@@ -0,0 +1,245 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"flag"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strings"
+	"sync"
+
+	log "github.com/golang/glog"
+	"github.com/openconfig/featureprofiles/internal/metadata"
+	"github.com/openconfig/gnmi/errlist"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+var (
+	validatePlatformExceptions = flag.Bool("validate_platform_exceptions", false, "Validate all platform_exceptions in metadata.textproto before the first deviation lookup, and exit if any of them are invalid or may match the same device with conflicting deviations.")
+
+	validateOnce sync.Once
+)
+
+// mustValidate validates the platform_exceptions of the test metadata once if the
+// -validate_platform_exceptions flag is set, and exits on any problem.
+func mustValidate() {
+	if !*validatePlatformExceptions {
+		return
+	}
+	validateOnce.Do(func() {
+		if err := ValidatePlatformExceptions(metadata.Get().GetPlatformExceptions()); err != nil {
+			log.Exitf("Invalid platform_exceptions in metadata.textproto: %v", err)
+		}
+	})
+}
+
+// ValidatePlatformExceptions checks that every platform_exceptions entry that sets
+// deviations specifies a vendor and valid regular expressions, and that no two entries
+// that can match the same (vendor, hardware model, software version) tuple set
+// conflicting deviation values.  Entries that set no deviations have no effect and are
+// ignored.
+//
+// Whether two regular expressions can match the same string is decided by generating
+// sample strings from each one and matching them against the other, so overlaps
+// between very different looking expressions may go undetected.
+func ValidatePlatformExceptions(pes []*mpb.Metadata_PlatformExceptions) error {
+	errs := errlist.List{Separator: "\n"}
+
+	type compiled struct {
+		model, version *regexp.Regexp
+	}
+	res := make([]*compiled, len(pes))
+	for i, pe := range pes {
+		if proto.Size(pe.GetDeviations()) == 0 {
+			continue
+		}
+		p := pe.GetPlatform()
+		if p.GetVendor() == 0 {
+			errs.Add(fmt.Errorf("platform_exceptions[%d]: vendor should be specified", i))
+			continue
+		}
+		c := &compiled{}
+		var err error
+		if c.model, err = compileRegex(p.GetHardwareModelRegex()); err != nil {
+			errs.Add(fmt.Errorf("platform_exceptions[%d]: invalid hardware_model_regex: %w", i, err))
+			continue
+		}
+		if c.version, err = compileRegex(p.GetSoftwareVersionRegex()); err != nil {
+			errs.Add(fmt.Errorf("platform_exceptions[%d]: invalid software_version_regex: %w", i, err))
+			continue
+		}
+		res[i] = c
+	}
+
+	for i := range pes {
+		for j := i + 1; j < len(pes); j++ {
+			if res[i] == nil || res[j] == nil {
+				continue
+			}
+			if pes[i].GetPlatform().GetVendor() != pes[j].GetPlatform().GetVendor() {
+				continue
+			}
+			if !mayOverlap(res[i].model, res[j].model) || !mayOverlap(res[i].version, res[j].version) {
+				continue
+			}
+			conflicts := conflictingDeviations(pes[i].GetDeviations(), pes[j].GetDeviations())
+			if len(conflicts) == 0 {
+				continue
+			}
+			errs.Add(fmt.Errorf("platform_exceptions[%d] and platform_exceptions[%d] may both match vendor %v with conflicting deviations: %s", i, j, pes[i].GetPlatform().GetVendor(), strings.Join(conflicts, ", ")))
+		}
+	}
+	return errs.Err()
+}
+
+// compileRegex compiles a platform regex; the empty string matches anything and is
+// returned as nil.
+func compileRegex(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(expr)
+}
+
+// mayOverlap returns true if both regular expressions may match the same string.  A nil
+// regular expression matches anything.
+func mayOverlap(a, b *regexp.Regexp) bool {
+	if a == nil || b == nil || a.String() == b.String() {
+		return true
+	}
+	for _, s := range samples(a) {
+		if b.MatchString(s) {
+			return true
+		}
+	}
+	for _, s := range samples(b) {
+		if a.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxSamples bounds the number of strings generated from a regular expression.
+const maxSamples = 64
+
+// samples returns strings matched by the regular expression.
+func samples(re *regexp.Regexp) []string {
+	r, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	return sampleRegexp(r.Simplify())
+}
+
+func sampleRegexp(r *syntax.Regexp) []string {
+	switch r.Op {
+	case syntax.OpLiteral:
+		return []string{string(r.Rune)}
+	case syntax.OpCharClass:
+		var ss []string
+		for i := 0; i+1 < len(r.Rune) && len(ss) < 4; i += 2 {
+			ss = append(ss, string(r.Rune[i]))
+		}
+		return ss
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return []string{"0", "a"}
+	case syntax.OpCapture:
+		return sampleRegexp(r.Sub[0])
+	case syntax.OpStar, syntax.OpQuest:
+		return append([]string{""}, sampleRegexp(r.Sub[0])...)
+	case syntax.OpPlus:
+		return sampleRegexp(r.Sub[0])
+	case syntax.OpRepeat:
+		sub := sampleRegexp(r.Sub[0])
+		ss := []string{""}
+		for n := 0; n < r.Min; n++ {
+			ss = product(ss, sub)
+		}
+		return ss
+	case syntax.OpConcat:
+		ss := []string{""}
+		for _, sub := range r.Sub {
+			ss = product(ss, sampleRegexp(sub))
+		}
+		return ss
+	case syntax.OpAlternate:
+		var ss []string
+		for _, sub := range r.Sub {
+			ss = append(ss, sampleRegexp(sub)...)
+		}
+		if len(ss) > maxSamples {
+			ss = ss[:maxSamples]
+		}
+		return ss
+	default:
+		// Empty matches and assertions such as ^, $ and \b.
+		return []string{""}
+	}
+}
+
+func product(xs, ys []string) []string {
+	var ss []string
+	for _, x := range xs {
+		for _, y := range ys {
+			if len(ss) == maxSamples {
+				return ss
+			}
+			ss = append(ss, x+y)
+		}
+	}
+	return ss
+}
+
+// conflictingDeviations returns the deviations set to different values in a and b.  A
+// deviation that is set in one and left at the default in the other also conflicts.
+func conflictingDeviations(a, b *mpb.Metadata_Deviations) []string {
+	var names []string
+	fds := (&mpb.Metadata_Deviations{}).ProtoReflect().Descriptor().Fields()
+	ar, br := a.ProtoReflect(), b.ProtoReflect()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if !ar.Has(fd) && !br.Has(fd) {
+			continue
+		}
+		if !equalValue(fd, ar.Get(fd), br.Get(fd)) {
+			names = append(names, string(fd.Name()))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func equalValue(fd protoreflect.FieldDescriptor, x, y protoreflect.Value) bool {
+	if !fd.IsList() {
+		return x.Interface() == y.Interface()
+	}
+	xl, yl := x.List(), y.List()
+	if xl.Len() != yl.Len() {
+		return false
+	}
+	for i := 0; i < xl.Len(); i++ {
+		if xl.Get(i).Interface() != yl.Get(i).Interface() {
+			return false
+		}
+	}
+	return true
+}
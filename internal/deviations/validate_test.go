@@ -0,0 +1,146 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+func TestValidatePlatformExceptions(t *testing.T) {
+	cases := []struct {
+		desc    string
+		textpb  string
+		wantErr string
+	}{{
+		desc: "disjoint",
+		textpb: `
+platform_exceptions: { platform: { vendor: ARISTA } deviations: { omit_l2_mtu: true } }
+platform_exceptions: { platform: { vendor: CISCO } deviations: { omit_l2_mtu: true } }
+platform_exceptions: { platform: { vendor: NOKIA hardware_model_regex: "^7250 IXR-10e$" } }
+platform_exceptions: { platform: { vendor: NOKIA hardware_model_regex: "^7250 IXR-e$" } }
+platform_exceptions: { platform: { vendor: JUNIPER software_version_regex: "^2[34]\\..*" } }
+platform_exceptions: { platform: { vendor: JUNIPER software_version_regex: "^22\\..*" } }
+`,
+	}, {
+		desc:   "no deviations",
+		textpb: `platform_exceptions: {}`,
+	}, {
+		desc:    "missing vendor",
+		textpb:  `platform_exceptions: { deviations: { omit_l2_mtu: true } }`,
+		wantErr: "platform_exceptions[0]: vendor should be specified",
+	}, {
+		desc:    "invalid regex",
+		textpb:  `platform_exceptions: { platform: { vendor: CISCO hardware_model_regex: "8[0-9" } deviations: { omit_l2_mtu: true } }`,
+		wantErr: "platform_exceptions[0]: invalid hardware_model_regex",
+	}, {
+		desc: "overlap with same values",
+		textpb: `
+platform_exceptions: { platform: { vendor: CISCO } deviations: { omit_l2_mtu: true } }
+platform_exceptions: { platform: { vendor: CISCO hardware_model_regex: "8808" } deviations: { omit_l2_mtu: true } }
+`,
+	}, {
+		desc: "empty regex overlaps",
+		textpb: `
+platform_exceptions: { platform: { vendor: CISCO } deviations: { omit_l2_mtu: true } }
+platform_exceptions: { platform: { vendor: CISCO hardware_model_regex: "8808" } deviations: { omit_l2_mtu: true ipv4_missing_enabled: true } }
+`,
+		wantErr: "platform_exceptions[0] and platform_exceptions[1] may both match vendor CISCO with conflicting deviations: ipv4_missing_enabled",
+	}, {
+		desc: "conflicting values",
+		textpb: `
+platform_exceptions: { platform: { vendor: CISCO hardware_model_regex: "^8[0-9]+$" } deviations: { omit_l2_mtu: true default_network_instance: "default" } }
+platform_exceptions: { platform: { vendor: CISCO hardware_model_regex: "8808|8201" } deviations: { default_network_instance: "DEFAULT" } }
+`,
+		wantErr: "with conflicting deviations: default_network_instance, omit_l2_mtu",
+	}}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			md := &mpb.Metadata{}
+			if err := prototext.Unmarshal([]byte(c.textpb), md); err != nil {
+				t.Fatalf("Unmarshal got error: %v", err)
+			}
+			err := ValidatePlatformExceptions(md.GetPlatformExceptions())
+			switch {
+			case c.wantErr == "" && err != nil:
+				t.Errorf("ValidatePlatformExceptions() got error %v, want nil", err)
+			case c.wantErr != "" && (err == nil || !strings.Contains(err.Error(), c.wantErr)):
+				t.Errorf("ValidatePlatformExceptions() got error %v, want containing %q", err, c.wantErr)
+			}
+		})
+	}
+}
+
+// TestMatchDeviationsAgreesWithValidate checks that the deviation lookup accepts the
+// overlapping platform_exceptions that ValidatePlatformExceptions accepts, and
+// rejects the ones it rejects.
+func TestMatchDeviationsAgreesWithValidate(t *testing.T) {
+	cases := []struct {
+		desc   string
+		textpb string
+		want   string
+	}{{
+		desc: "overlap without deviations",
+		textpb: `
+platform_exceptions: { platform: { vendor: CISCO } deviations: { omit_l2_mtu: true } }
+platform_exceptions: { platform: { vendor: CISCO hardware_model_regex: "8808" } }
+platform_exceptions: {}
+`,
+		want: `omit_l2_mtu: true`,
+	}, {
+		desc: "overlap with same values",
+		textpb: `
+platform_exceptions: { platform: { vendor: CISCO } deviations: { omit_l2_mtu: true } }
+platform_exceptions: { platform: { vendor: CISCO hardware_model_regex: "8808" } deviations: { omit_l2_mtu: true } }
+`,
+		want: `omit_l2_mtu: true`,
+	}, {
+		desc: "overlap with conflicting values",
+		textpb: `
+platform_exceptions: { platform: { vendor: CISCO } deviations: { omit_l2_mtu: true } }
+platform_exceptions: { platform: { vendor: CISCO hardware_model_regex: "8808" } deviations: { omit_l2_mtu: true ipv4_missing_enabled: true } }
+`,
+	}}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			md := &mpb.Metadata{}
+			if err := prototext.Unmarshal([]byte(c.textpb), md); err != nil {
+				t.Fatalf("Unmarshal got error: %v", err)
+			}
+			validateErr := ValidatePlatformExceptions(md.GetPlatformExceptions())
+			got, matchErr := matchDeviations(md.GetPlatformExceptions(), "CISCO", "8808", "24.1")
+			if (validateErr == nil) != (matchErr == nil) {
+				t.Fatalf("ValidatePlatformExceptions() got error %v, but matchDeviations() got error %v", validateErr, matchErr)
+			}
+			if matchErr != nil {
+				return
+			}
+			want := &mpb.Metadata_Deviations{}
+			if err := prototext.Unmarshal([]byte(c.want), want); err != nil {
+				t.Fatalf("Unmarshal got error: %v", err)
+			}
+			if !proto.Equal(got, want) {
+				t.Errorf("matchDeviations() got %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -97,19 +97,6 @@ func GetDeviceConfig(t testing.TB, dev gnmi.DeviceOrOpts) *oc.Root {
 		}
 	}
 
-	if *pruneComponents {
-		for cname, component := range config.Component {
-			// Keep the port components in order to preserve the breakout-mode config.
-			if component.GetPort() == nil {
-				delete(config.Component, cname)
-				continue
-			}
-			// Need to prune subcomponents that may have a leafref to a component that was
-			// pruned.
-			component.Subcomponent = nil
-		}
-	}
-
 	if *setEthernetFromState {
 		for iname, iface := range config.Interface {
 			if iface.GetEthernet() == nil {
@@ -152,16 +139,7 @@ func GetDeviceConfig(t testing.TB, dev gnmi.DeviceOrOpts) *oc.Root {
 		}
 	}
 
-	if *pruneLLDP && config.Lldp != nil {
-		config.Lldp.ChassisId = nil
-		config.Lldp.ChassisIdType = oc.Lldp_ChassisIdType_UNSET
-	}
-
-	if *pruneQoS {
-		config.Qos = nil
-	}
-
-	pruneUnsupportedPaths(config)
+	prunePaths(config)
 
 	WriteQuery(t, "Touched", gnmi.OC().Config(), config)
 	return config
@@ -188,6 +166,34 @@ func CopyDeviceConfig(t testing.TB, dut *ondatra.DUTDevice, config *oc.Root) *oc
 	return copyConfig
 }
 
+// prunePaths prunes the parts of a device config that cannot be pushed out again, as
+// selected by the prune flags.
+func prunePaths(config *oc.Root) {
+	if *pruneComponents {
+		for cname, component := range config.Component {
+			// Keep the port components in order to preserve the breakout-mode config.
+			if component.GetPort() == nil {
+				delete(config.Component, cname)
+				continue
+			}
+			// Need to prune subcomponents that may have a leafref to a component that was
+			// pruned.
+			component.Subcomponent = nil
+		}
+	}
+
+	if *pruneLLDP && config.Lldp != nil {
+		config.Lldp.ChassisId = nil
+		config.Lldp.ChassisIdType = oc.Lldp_ChassisIdType_UNSET
+	}
+
+	if *pruneQoS {
+		config.Qos = nil
+	}
+
+	pruneUnsupportedPaths(config)
+}
+
 func pruneUnsupportedPaths(config *oc.Root) {
 	for _, ni := range config.NetworkInstance {
 		ni.Fdb = nil
@@ -0,0 +1,387 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygnmi/ygnmi"
+	"github.com/openconfig/ygot/ytypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+var datapointChecksFlag = flag.String("datapoint_checks", "", "Comma separated list of additional checks of the gNMI datapoints received on the Subscribe streams of the static binding, reported by CheckDatapoints.  Available: schema, timestamp_order, duplicates (ON_CHANGE streams only).")
+
+// datapointChecks are the checks enabled by the -datapoint_checks flag.
+type datapointChecks struct {
+	// schema checks the type and range of values against the OpenConfig schema.
+	schema bool
+	// timestampOrder checks that the timestamps of a path do not go backwards.
+	timestampOrder bool
+	// duplicates checks that a path is not updated with the value it already has,
+	// which is only expected of ON_CHANGE subscriptions; SAMPLE subscriptions
+	// resend values that have not changed.
+	duplicates bool
+}
+
+func parseDatapointChecks(s string) (datapointChecks, error) {
+	var checks datapointChecks
+	for _, name := range strings.Split(s, ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+		case "schema":
+			checks.schema = true
+		case "timestamp_order":
+			checks.timestampOrder = true
+		case "duplicates":
+			checks.duplicates = true
+		default:
+			return checks, fmt.Errorf("unknown datapoint check %q", name)
+		}
+	}
+	return checks, nil
+}
+
+// datapointViolation is a datapoint that failed one of the datapoint checks.
+type datapointViolation struct {
+	check  string
+	target string
+	path   string
+	msg    string
+}
+
+// datapointStream is a gNMI Subscribe call to a target.  The timestamp_order and
+// duplicates checks only compare the datapoints received on the same stream.
+type datapointStream struct {
+	target string
+	id     uint64
+	// onChange is whether every subscription of the stream is ON_CHANGE.
+	onChange bool
+}
+
+// datapointChecker runs the datapoint checks and keeps the violations.  Violations
+// are kept rather than returned, so that they do not abort the query.
+type datapointChecker struct {
+	checks datapointChecks
+
+	mu     sync.Mutex
+	nextID uint64
+	// last holds the last datapoint received for each path of each open stream.  It
+	// is only kept for the timestamp_order and duplicates checks.
+	last       map[uint64]map[string]*ygnmi.DataPoint
+	violations []datapointViolation
+}
+
+func newDatapointChecker(checks datapointChecks) *datapointChecker {
+	return &datapointChecker{
+		checks: checks,
+		last:   make(map[uint64]map[string]*ygnmi.DataPoint),
+	}
+}
+
+// datapoints checks every datapoint received; it runs no checks until RunTests
+// enables them from the -datapoint_checks flag.
+var datapoints = newDatapointChecker(datapointChecks{})
+
+// newStream returns a new stream to a target.
+func (c *datapointChecker) newStream(target string) *datapointStream {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextID++
+	return &datapointStream{target: target, id: c.nextID}
+}
+
+// endStream forgets the datapoints received on a stream.
+func (c *datapointChecker) endStream(s *datapointStream) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.last, s.id)
+}
+
+func (c *datapointChecker) check(s *datapointStream, dp *ygnmi.DataPoint) {
+	if c.checks == (datapointChecks{}) {
+		return
+	}
+	path := pathString(dp.Path)
+	var found []datapointViolation
+	if c.checks.schema && dp.Value != nil {
+		if err := schemaViolation(dp); err != nil {
+			found = append(found, datapointViolation{check: "schema", target: s.target, path: path, msg: err.Error()})
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.checks.timestampOrder || c.checks.duplicates {
+		found = append(found, c.compareLast(s, path, dp)...)
+	}
+	c.violations = append(c.violations, found...)
+}
+
+// compareLast runs the timestamp_order and duplicates checks of a datapoint against
+// the last one received for its path on the stream.  c.mu must be held.
+func (c *datapointChecker) compareLast(s *datapointStream, path string, dp *ygnmi.DataPoint) []datapointViolation {
+	last := c.last[s.id]
+	if last == nil {
+		last = make(map[string]*ygnmi.DataPoint)
+		c.last[s.id] = last
+	}
+	prev := last[path]
+	var found []datapointViolation
+	switch {
+	case prev == nil || dp.Timestamp.IsZero() || prev.Timestamp.IsZero():
+	case dp.Timestamp.Before(prev.Timestamp):
+		if c.checks.timestampOrder {
+			found = append(found, datapointViolation{check: "timestamp_order", target: s.target, path: path, msg: fmt.Sprintf("timestamp %v is before the previous timestamp %v", dp.Timestamp.UnixNano(), prev.Timestamp.UnixNano())})
+		}
+		// Keep the latest datapoint to compare the next one against.
+		dp = prev
+	case c.checks.duplicates && s.onChange && dp.Timestamp.After(prev.Timestamp) && dp.Value != nil && proto.Equal(dp.Value, prev.Value):
+		found = append(found, datapointViolation{check: "duplicates", target: s.target, path: path, msg: fmt.Sprintf("duplicate update of value %s at %v, previously at %v", valueString(dp.Value), dp.Timestamp.UnixNano(), prev.Timestamp.UnixNano())})
+	}
+	last[path] = dp
+	return found
+}
+
+// streamInterceptor returns a gRPC stream interceptor that checks the datapoints
+// received by the gNMI Subscribe calls to a target.
+func (c *datapointChecker) streamInterceptor(target string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil || method != gpb.GNMI_Subscribe_FullMethodName {
+			return cs, err
+		}
+		return &checkedStream{ClientStream: cs, c: c, s: c.newStream(target)}, nil
+	}
+}
+
+// checkedStream is a gNMI Subscribe stream whose datapoints are checked.
+type checkedStream struct {
+	grpc.ClientStream
+	c *datapointChecker
+	s *datapointStream
+}
+
+func (cs *checkedStream) SendMsg(m any) error {
+	if req, ok := m.(*gpb.SubscribeRequest); ok && req.GetSubscribe() != nil {
+		list := req.GetSubscribe()
+		cs.s.onChange = list.GetMode() == gpb.SubscriptionList_STREAM && len(list.GetSubscription()) > 0
+		for _, sub := range list.GetSubscription() {
+			if sub.GetMode() != gpb.SubscriptionMode_ON_CHANGE {
+				cs.s.onChange = false
+			}
+		}
+	}
+	return cs.ClientStream.SendMsg(m)
+}
+
+func (cs *checkedStream) RecvMsg(m any) error {
+	if err := cs.ClientStream.RecvMsg(m); err != nil {
+		cs.c.endStream(cs.s)
+		return err
+	}
+	if resp, ok := m.(*gpb.SubscribeResponse); ok {
+		for _, dp := range notificationDatapoints(resp.GetUpdate()) {
+			cs.c.check(cs.s, dp)
+		}
+	}
+	return nil
+}
+
+// notificationDatapoints returns the datapoints of the updates and deletes of a
+// notification.
+func notificationDatapoints(n *gpb.Notification) []*ygnmi.DataPoint {
+	if n == nil {
+		return nil
+	}
+	ts := time.Unix(0, n.GetTimestamp())
+	path := func(p *gpb.Path) *gpb.Path {
+		origin := n.GetPrefix().GetOrigin()
+		if origin == "" {
+			origin = p.GetOrigin()
+		}
+		return &gpb.Path{
+			Origin: origin,
+			Elem:   append(append([]*gpb.PathElem(nil), n.GetPrefix().GetElem()...), p.GetElem()...),
+		}
+	}
+	var dps []*ygnmi.DataPoint
+	for _, u := range n.GetUpdate() {
+		dps = append(dps, &ygnmi.DataPoint{Path: path(u.GetPath()), Value: u.GetVal(), Timestamp: ts})
+	}
+	for _, p := range n.GetDelete() {
+		dps = append(dps, &ygnmi.DataPoint{Path: path(p), Timestamp: ts})
+	}
+	return dps
+}
+
+// mark returns the position of the next violation, for use with since.
+func (c *datapointChecker) mark() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.violations)
+}
+
+// since returns the violations found after mark.
+func (c *datapointChecker) since(mark int) []datapointViolation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]datapointViolation(nil), c.violations[mark:]...)
+}
+
+var ocSchema = sync.OnceValues(oc.Schema)
+
+// schemaViolation returns an error if the value of the datapoint does not conform to
+// its type in the OpenConfig schema.  Paths in other origins are not checked.
+func schemaViolation(dp *ygnmi.DataPoint) error {
+	if origin := dp.Path.GetOrigin(); origin != "" && origin != "openconfig" {
+		return nil
+	}
+	schema, err := ocSchema()
+	if err != nil {
+		return err
+	}
+	// The oc structs map config paths to shadow paths, which are only set when they are
+	// preferred, so set the value both ways.
+	for _, opts := range [][]ytypes.SetNodeOpt{
+		{&ytypes.InitMissingElements{}},
+		{&ytypes.InitMissingElements{}, &ytypes.PreferShadowPath{}},
+	} {
+		root := &oc.Root{}
+		if err := ytypes.SetNode(schema.RootSchema(), root, dp.Path, dp.Value, opts...); err != nil {
+			return fmt.Errorf("invalid value %s: %s", valueString(dp.Value), status.Convert(err).Message())
+		}
+		if errs := ytypes.Validate(schema.RootSchema(), root); len(errs) > 0 {
+			return fmt.Errorf("invalid value %s: %v", valueString(dp.Value), errs)
+		}
+	}
+	return decimalViolation(schemaEntry(schema.RootSchema(), dp.Path), dp.Value)
+}
+
+// schemaEntry returns the schema entry of a path, or nil if it is not found.
+func schemaEntry(root *yang.Entry, path *gpb.Path) *yang.Entry {
+	e := root
+	for _, elem := range path.GetElem() {
+		name := elem.GetName()
+		if i := strings.Index(name, ":"); i >= 0 {
+			name = name[i+1:]
+		}
+		if e = e.Dir[name]; e == nil {
+			return nil
+		}
+	}
+	return e
+}
+
+// decimalViolation returns an error if a value of a decimal64 leaf has more fraction
+// digits than its type allows.
+func decimalViolation(e *yang.Entry, v *gpb.TypedValue) error {
+	if e == nil || e.Type == nil || e.Type.Kind != yang.Ydecimal64 {
+		return nil
+	}
+	digits := int(e.Type.FractionDigits)
+	switch v.GetValue().(type) {
+	case *gpb.TypedValue_DecimalVal:
+		if p := int(v.GetDecimalVal().GetPrecision()); p > digits {
+			return fmt.Errorf("decimal value %s has precision %d, want at most %d fraction digits", valueString(v), p, digits)
+		}
+	case *gpb.TypedValue_DoubleVal, *gpb.TypedValue_FloatVal:
+		f := v.GetDoubleVal()
+		if _, ok := v.GetValue().(*gpb.TypedValue_FloatVal); ok {
+			f = float64(v.GetFloatVal())
+		}
+		scaled := f * math.Pow10(digits)
+		if math.Abs(scaled-math.Round(scaled)) > 1e-6*math.Max(1, math.Abs(scaled)) {
+			return fmt.Errorf("decimal value %s has more than %d fraction digits", valueString(v), digits)
+		}
+	}
+	return nil
+}
+
+// datapointReport formats violations, listing each check, target and path once with the
+// first violation and the number of others.
+func datapointReport(violations []datapointViolation) string {
+	type key struct{ check, target, path string }
+	first := make(map[key]string)
+	count := make(map[key]int)
+	var keys []key
+	for _, v := range violations {
+		k := key{v.check, v.target, v.path}
+		if count[k] == 0 {
+			first[k] = v.msg
+			keys = append(keys, k)
+		}
+		count[k]++
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].check != keys[j].check {
+			return keys[i].check < keys[j].check
+		}
+		if keys[i].target != keys[j].target {
+			return keys[i].target < keys[j].target
+		}
+		return keys[i].path < keys[j].path
+	})
+	b := new(strings.Builder)
+	for _, k := range keys {
+		fmt.Fprintf(b, "[%s] %s %s: %s", k.check, k.target, k.path, first[k])
+		if n := count[k] - 1; n > 0 {
+			fmt.Fprintf(b, " (and %d more)", n)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// CheckDatapoints reports the violations of the -datapoint_checks found in the gNMI
+// datapoints received on the Subscribe streams of the static binding while the test
+// runs.  When the test ends, the violations are
+// logged and written to a *.datapoints.*.txt file in the directory specified by the
+// -outputs_dir flag.  Violations do not fail the test or abort the queries.
+//
+// The datapoints are checked for all tests, so the report of a test running in
+// parallel with others also includes the violations found by the others.
+func CheckDatapoints(t testing.TB) {
+	t.Helper()
+	mark := datapoints.mark()
+	start := time.Now()
+	t.Cleanup(func() {
+		violations := datapoints.since(mark)
+		if len(violations) == 0 {
+			return
+		}
+		report := datapointReport(violations)
+		t.Logf("Found %d datapoint violations in %v:\n%s", len(violations), time.Since(start).Round(time.Second), report)
+		if name, err := WriteOutput(t.Name()+".datapoints", ".txt", report); err != nil {
+			t.Errorf("Cannot write the datapoint violations: %v", err)
+		} else {
+			t.Logf("Datapoint violations written to %s", name)
+		}
+	})
+}
@@ -0,0 +1,267 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ygnmi/ygnmi"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func mustPath(t *testing.T, s string) *gpb.Path {
+	t.Helper()
+	p, err := ygot.StringToStructuredPath(s)
+	if err != nil {
+		t.Fatalf("Cannot parse path %q: %v", s, err)
+	}
+	return p
+}
+
+func TestParseDatapointChecks(t *testing.T) {
+	got, err := parseDatapointChecks("schema, duplicates")
+	if err != nil {
+		t.Fatalf("parseDatapointChecks got error: %v", err)
+	}
+	if want := (datapointChecks{schema: true, duplicates: true}); got != want {
+		t.Errorf("parseDatapointChecks got %+v, want %+v", got, want)
+	}
+	if _, err := parseDatapointChecks("schema,bogus"); err == nil {
+		t.Error("parseDatapointChecks with unknown check got no error")
+	}
+}
+
+func TestSchemaViolation(t *testing.T) {
+	const (
+		operStatus  = "/interfaces/interface[name=eth0]/state/oper-status"
+		mtu         = "/interfaces/interface[name=eth0]/config/mtu"
+		temperature = "/components/component[name=chassis]/state/temperature/instant"
+	)
+	tests := []struct {
+		desc    string
+		path    string
+		val     *gpb.TypedValue
+		wantErr string
+	}{{
+		desc: "valid enum",
+		path: operStatus,
+		val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "UP"}},
+	}, {
+		desc:    "invalid enum",
+		path:    operStatus,
+		val:     &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "SIDEWAYS"}},
+		wantErr: "SIDEWAYS is not a valid value",
+	}, {
+		desc: "uint in range",
+		path: mtu,
+		val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 9000}},
+	}, {
+		desc:    "uint out of range",
+		path:    mtu,
+		val:     &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 70000}},
+		wantErr: "uint16",
+	}, {
+		desc:    "wrong type",
+		path:    mtu,
+		val:     &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "big"}},
+		wantErr: "invalid value",
+	}, {
+		desc: "decimal with allowed precision",
+		path: temperature,
+		val:  &gpb.TypedValue{Value: &gpb.TypedValue_DoubleVal{DoubleVal: 42.5}},
+	}, {
+		desc:    "double with too many fraction digits",
+		path:    temperature,
+		val:     &gpb.TypedValue{Value: &gpb.TypedValue_DoubleVal{DoubleVal: 42.55}},
+		wantErr: "more than 1 fraction digits",
+	}, {
+		desc:    "decimal with too much precision",
+		path:    temperature,
+		val:     &gpb.TypedValue{Value: &gpb.TypedValue_DecimalVal{DecimalVal: &gpb.Decimal64{Digits: 4255, Precision: 2}}},
+		wantErr: "precision 2",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := schemaViolation(&ygnmi.DataPoint{Path: mustPath(t, test.path), Value: test.val})
+			if test.wantErr == "" {
+				if err != nil {
+					t.Errorf("schemaViolation got error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("schemaViolation got error %v, want error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestDatapointChecker(t *testing.T) {
+	const path = "/interfaces/interface[name=eth0]/state/description"
+	start := time.Unix(1707215426, 0)
+	val := func(s string) *gpb.TypedValue {
+		return &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: s}}
+	}
+	c := newDatapointChecker(datapointChecks{timestampOrder: true, duplicates: true})
+	onChange := c.newStream("dut1")
+	onChange.onChange = true
+	sample := c.newStream("dut1")
+	other := c.newStream("dut2")
+	other.onChange = true
+	dps := []struct {
+		s  *datapointStream
+		dp *ygnmi.DataPoint
+	}{
+		{onChange, &ygnmi.DataPoint{Value: val("a"), Timestamp: start}},
+		{onChange, &ygnmi.DataPoint{Value: val("a"), Timestamp: start}},                  // resent
+		{onChange, &ygnmi.DataPoint{Value: val("b"), Timestamp: start.Add(time.Second)}}, // changed
+		{onChange, &ygnmi.DataPoint{Value: val("b"), Timestamp: start.Add(2 * time.Second)}},
+		{onChange, &ygnmi.DataPoint{Value: val("c"), Timestamp: start.Add(-time.Second)}},
+		{onChange, &ygnmi.DataPoint{Value: val("b"), Timestamp: start.Add(3 * time.Second)}},
+		{sample, &ygnmi.DataPoint{Value: val("b"), Timestamp: start.Add(time.Second)}},
+		{sample, &ygnmi.DataPoint{Value: val("b"), Timestamp: start.Add(2 * time.Second)}}, // sampled again
+		{other, &ygnmi.DataPoint{Value: val("a"), Timestamp: start}},                       // another DUT
+	}
+	for _, d := range dps {
+		d.dp.Path = mustPath(t, path)
+		c.check(d.s, d.dp)
+	}
+	var got []string
+	for _, v := range c.since(0) {
+		got = append(got, v.check+" "+v.target)
+	}
+	want := []string{"duplicates dut1", "timestamp_order dut1", "duplicates dut1"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("datapointChecker got unexpected violations (-want,+got):\n%s", diff)
+	}
+
+	for _, s := range []*datapointStream{onChange, sample, other} {
+		c.endStream(s)
+	}
+	if len(c.last) != 0 {
+		t.Errorf("datapointChecker kept the datapoints of %d ended streams", len(c.last))
+	}
+}
+
+func TestDatapointCheckerSchemaOnly(t *testing.T) {
+	c := newDatapointChecker(datapointChecks{schema: true})
+	c.check(c.newStream("dut"), &ygnmi.DataPoint{
+		Path:      mustPath(t, "/interfaces/interface[name=eth0]/config/mtu"),
+		Value:     &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 9000}},
+		Timestamp: time.Unix(1707215426, 0),
+	})
+	if len(c.last) != 0 {
+		t.Errorf("datapointChecker with only the schema check kept the last datapoints of %d streams", len(c.last))
+	}
+}
+
+// fakeSubscribeStream is a gNMI Subscribe stream that receives fixed responses.
+type fakeSubscribeStream struct {
+	grpc.ClientStream
+	resps []*gpb.SubscribeResponse
+}
+
+func (s *fakeSubscribeStream) SendMsg(any) error { return nil }
+
+func (s *fakeSubscribeStream) RecvMsg(m any) error {
+	if len(s.resps) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(*gpb.SubscribeResponse), s.resps[0])
+	s.resps = s.resps[1:]
+	return nil
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	update := func(ts int64) *gpb.SubscribeResponse {
+		return &gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: &gpb.Notification{
+			Timestamp: ts,
+			Prefix:    &gpb.Path{Origin: "openconfig", Elem: []*gpb.PathElem{{Name: "interfaces"}}},
+			Update: []*gpb.Update{{
+				Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "interface", Key: map[string]string{"name": "eth0"}}, {Name: "state"}, {Name: "description"}}},
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "a"}},
+			}},
+		}}}
+	}
+	tests := []struct {
+		desc string
+		mode gpb.SubscriptionMode
+		want []string
+	}{{
+		desc: "on change",
+		mode: gpb.SubscriptionMode_ON_CHANGE,
+		want: []string{"[duplicates] dut /interfaces/interface[name=eth0]/state/description"},
+	}, {
+		desc: "sample",
+		mode: gpb.SubscriptionMode_SAMPLE,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := newDatapointChecker(datapointChecks{duplicates: true})
+			streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+				return &fakeSubscribeStream{resps: []*gpb.SubscribeResponse{update(1707215426000000000), update(1707215427000000000)}}, nil
+			}
+			cs, err := c.streamInterceptor("dut")(context.Background(), nil, nil, gpb.GNMI_Subscribe_FullMethodName, streamer)
+			if err != nil {
+				t.Fatalf("streamInterceptor got error: %v", err)
+			}
+			req := &gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: &gpb.SubscriptionList{
+				Mode:         gpb.SubscriptionList_STREAM,
+				Subscription: []*gpb.Subscription{{Mode: test.mode}},
+			}}}
+			if err := cs.SendMsg(req); err != nil {
+				t.Fatalf("SendMsg got error: %v", err)
+			}
+			for {
+				if err := cs.RecvMsg(new(gpb.SubscribeResponse)); err != nil {
+					break
+				}
+			}
+			var got []string
+			for _, v := range c.since(0) {
+				got = append(got, fmt.Sprintf("[%s] %s %s", v.check, v.target, v.path))
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("streamInterceptor got unexpected violations (-want,+got):\n%s", diff)
+			}
+			if len(c.last) != 0 {
+				t.Errorf("streamInterceptor kept the datapoints of the ended stream")
+			}
+		})
+	}
+}
+
+func TestDatapointReport(t *testing.T) {
+	violations := []datapointViolation{
+		{check: "schema", target: "dut", path: "/b", msg: "bad b"},
+		{check: "duplicates", target: "dut", path: "/a", msg: "dup a"},
+		{check: "schema", target: "dut", path: "/b", msg: "worse b"},
+	}
+	want := "[duplicates] dut /a: dup a\n[schema] dut /b: bad b (and 1 more)\n"
+	if diff := cmp.Diff(want, datapointReport(violations)); diff != "" {
+		t.Errorf("datapointReport got unexpected report (-want,+got):\n%s", diff)
+	}
+}
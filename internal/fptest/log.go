@@ -148,3 +148,36 @@ func logQuery(t testing.TB, what string, query LoggableQuery, obj ygot.GoStruct,
 		ondatra.Report().AddTestProperty(t, "test_output0", filename)
 	}
 }
+
+// RecordChecks returns a collector for check.Validator results.  Validators
+// wrapped by its Record method add a structured result for every Check and
+// Await, and when the test ends, the results are written as JSON to a
+// *.checks.*.json file in the directory specified by the -outputs_dir flag.
+//
+//	checks := fptest.RecordChecks(t)
+//	if err := checks.Record(check.Equal(query, want)).AwaitFor(time.Minute, client); err != nil {
+//		t.Error(err)
+//	}
+func RecordChecks(t testing.TB) *check.Collector {
+	t.Helper()
+	c := check.NewCollector()
+	t.Cleanup(func() {
+		if len(c.Results()) == 0 {
+			return
+		}
+		b := new(strings.Builder)
+		if err := c.WriteJSON(b); err != nil {
+			t.Errorf("Could not render check results: %v", err)
+			return
+		}
+		name, err := WriteOutput(t.Name()+".checks", ".json", b.String())
+		if err != nil {
+			t.Errorf("Could not write check results: %v", err)
+			return
+		}
+		if name != "" {
+			t.Logf("Check results written to %s", name)
+		}
+	})
+	return c
+}
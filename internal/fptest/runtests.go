@@ -17,6 +17,7 @@ package fptest
 import (
 	"flag"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 	"testing"
@@ -46,17 +47,28 @@ func RunTests(m *testing.M) {
 	if err := initMetadata(); err != nil {
 		log.Errorf("Unable to initialize test metadata: %v", err)
 	}
+	if err := binding.ValidateTestbed(flag.Lookup("testbed").Value.String()); err != nil {
+		log.Exitf("Invalid binding: %v", err)
+	}
+	checks, err := parseDatapointChecks(*datapointChecksFlag)
+	if err != nil {
+		log.Exitf("Invalid -datapoint_checks: %v", err)
+	}
+	datapoints = newDatapointChecker(checks)
+	if checks != (datapointChecks{}) {
+		binding.AddStreamInterceptor(datapoints.streamInterceptor)
+	}
 	ygnmi.WithDatapointValidator(datapointValidator)
 	ondatra.RunTests(m, binding.New)
 }
 
 func initMetadata() error {
+	flag.Parse()
 	if err := metadata.Init(); err != nil {
 		return err
 	}
 
 	// Set the testbed path from the metadata if it is not set.
-	flag.Parse()
 	if flagVal := flag.Lookup("testbed").Value; flagVal.String() == "" {
 		testbedPath, err := testbedPathFromMetadata()
 		if err != nil {
@@ -70,32 +82,47 @@ func initMetadata() error {
 	return nil
 }
 
+// testbedFiles maps every testbed in the metadata to its file in topologies/.
+var testbedFiles = map[mpb.Metadata_Testbed]string{
+	mpb.Metadata_TESTBED_DUT:                   "dut.testbed",
+	mpb.Metadata_TESTBED_DUT_DUT_4LINKS:        "dutdut.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_2LINKS:        "atedut_2.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_4LINKS:        "atedut_4.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_9LINKS_LAG:    "atedut_9_lag.testbed",
+	mpb.Metadata_TESTBED_DUT_DUT_ATE_2LINKS:    "dutdutate.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_8LINKS:        "atedut_8.testbed",
+	mpb.Metadata_TESTBED_DUT_400ZR:             "dut_400zr.testbed",
+	mpb.Metadata_TESTBED_DUT_400ZR_PLUS:        "dut_400zr_plus.testbed",
+	mpb.Metadata_TESTBED_DUT_400ZR_100G_4LINKS: "dut_400zr_100g_4links.testbed",
+	mpb.Metadata_TESTBED_DUT_400FR_100G_4LINKS: "dut_400fr_100g_4links.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_5LINKS:        "atedut_5.testbed",
+	mpb.Metadata_TESTBED_DUT_800ZR:             "dut_800zr.testbed",
+	mpb.Metadata_TESTBED_DUT_800ZR_PLUS:        "dut_800zr_plus.testbed",
+	mpb.Metadata_TESTBED_DUT_2LINKS:            "dut_2links.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_34LINKS:       "atedut_34.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_8LINKS_LAG:    "atedut_8_lag.testbed",
+}
+
 func testbedPathFromMetadata() (string, error) {
-	testbed := metadata.Get().Testbed
-	testbedToFile := map[mpb.Metadata_Testbed]string{
-		mpb.Metadata_TESTBED_DUT:                   "dut.testbed",
-		mpb.Metadata_TESTBED_DUT_DUT_4LINKS:        "dutdut.testbed",
-		mpb.Metadata_TESTBED_DUT_ATE_2LINKS:        "atedut_2.testbed",
-		mpb.Metadata_TESTBED_DUT_ATE_4LINKS:        "atedut_4.testbed",
-		mpb.Metadata_TESTBED_DUT_ATE_8LINKS_LAG:    "atedut_8_lag.testbed",
-		mpb.Metadata_TESTBED_DUT_ATE_9LINKS_LAG:    "atedut_9_lag.testbed",
-		mpb.Metadata_TESTBED_DUT_DUT_ATE_2LINKS:    "dutdutate.testbed",
-		mpb.Metadata_TESTBED_DUT_ATE_8LINKS:        "atedut_8.testbed",
-		mpb.Metadata_TESTBED_DUT_400ZR:             "dut_400zr.testbed",
-		mpb.Metadata_TESTBED_DUT_400ZR_PLUS:        "dut_400zr_plus.testbed",
-		mpb.Metadata_TESTBED_DUT_400ZR_100G_4LINKS: "dut_400zr_100g_4links.testbed",
-		mpb.Metadata_TESTBED_DUT_400FR_100G_4LINKS: "dut_400fr_100g_4links.testbed",
-		mpb.Metadata_TESTBED_DUT_2LINKS:            "dut_2links.testbed",
+	rootPath, err := pathutil.RootPath()
+	if err != nil {
+		return "", err
 	}
-	testbedFile, ok := testbedToFile[testbed]
+	return testbedPath(filepath.Join(rootPath, "topologies"), metadata.Get().Testbed)
+}
+
+// testbedPath returns the path of the file of a testbed in the topologies directory,
+// checking that the file exists.
+func testbedPath(topologiesDir string, testbed mpb.Metadata_Testbed) (string, error) {
+	testbedFile, ok := testbedFiles[testbed]
 	if !ok {
 		return "", fmt.Errorf("no testbed file for testbed %v", testbed)
 	}
-	rootPath, err := pathutil.RootPath()
-	if err != nil {
-		return "", err
+	path := filepath.Join(topologiesDir, testbedFile)
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("testbed file for testbed %v: %w", testbed, err)
 	}
-	return filepath.Join(rootPath, "topologies", testbedFile), nil
+	return path, nil
 }
 
 // datapointValidator is a ygnmi.ValidateFn that validates the timestamp of an input datapoint.
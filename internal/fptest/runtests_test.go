@@ -0,0 +1,42 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"testing"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+func TestTestbedPath(t *testing.T) {
+	for v := range mpb.Metadata_Testbed_name {
+		testbed := mpb.Metadata_Testbed(v)
+		if testbed == mpb.Metadata_TESTBED_UNSPECIFIED {
+			continue
+		}
+		if _, err := testbedPath("../../topologies", testbed); err != nil {
+			t.Errorf("testbedPath(%v) got error: %v", testbed, err)
+		}
+	}
+}
+
+func TestTestbedPath_Error(t *testing.T) {
+	if got, err := testbedPath("../../topologies", mpb.Metadata_TESTBED_UNSPECIFIED); err == nil {
+		t.Errorf("testbedPath(%v) got %q, want error", mpb.Metadata_TESTBED_UNSPECIFIED, got)
+	}
+	if got, err := testbedPath(t.TempDir(), mpb.Metadata_TESTBED_DUT); err == nil {
+		t.Errorf("testbedPath(%v) in empty directory got %q, want error", mpb.Metadata_TESTBED_DUT, got)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/ondatra"
+	"github.com/openconfig/ondatra/gnmi"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// ConfigSnapshot is the config of a DUT taken by SnapshotConfig, which the DUT is
+// restored to when the test ends.
+type ConfigSnapshot struct {
+	dut *ondatra.DUTDevice
+	// before is the config as fetched, used to find the drift.
+	before *oc.Root
+	// pushable is the config refurbished by GetDeviceConfig, used to restore.
+	pushable *oc.Root
+}
+
+// SnapshotConfig takes a snapshot of the full config of a DUT, and restores the DUT to
+// it when the test ends.  Before restoring, the config leaves that the test left
+// behind are logged and written to a *.drift.*.txt file in -outputs_dir.  Paths that
+// GetDeviceConfig prunes because they cannot be pushed out again are ignored.
+//
+// A test that mutates the DUT config should take a snapshot first, so that a failure
+// does not leave the DUT in a state that fails the next test:
+//
+//	func TestFoo(t *testing.T) {
+//		dut := ondatra.DUT(t, "dut")
+//		fptest.SnapshotConfig(t, dut)
+//		...
+//	}
+func SnapshotConfig(t testing.TB, dut *ondatra.DUTDevice) *ConfigSnapshot {
+	t.Helper()
+	s := &ConfigSnapshot{
+		dut:      dut,
+		before:   gnmi.Get[*oc.Root](t, dut, gnmi.OC().Config()),
+		pushable: GetDeviceConfig(t, dut),
+	}
+	t.Cleanup(func() { s.Restore(t) })
+	return s
+}
+
+// Drift returns the config leaves that changed since the snapshot, sorted by path.
+// Updated leaves are formatted as "path: value" and deleted leaves as "-path".
+func (s *ConfigSnapshot) Drift(t testing.TB) []string {
+	t.Helper()
+	after := gnmi.Get[*oc.Root](t, s.dut, gnmi.OC().Config())
+	drift, err := configDrift(s.before, after)
+	if err != nil {
+		t.Fatalf("Cannot compare the config of %s to its snapshot: %v", s.dut.Name(), err)
+	}
+	return drift
+}
+
+// Restore reports the drift of the DUT config from the snapshot, and replaces the
+// config with the snapshot if there is any.  It is called when the test ends, but it
+// may also be called earlier, e.g. between subtests.
+func (s *ConfigSnapshot) Restore(t testing.TB) {
+	t.Helper()
+	drift := s.Drift(t)
+	if len(drift) == 0 {
+		t.Logf("Config of %s did not drift from its snapshot.", s.dut.Name())
+		return
+	}
+	report := strings.Join(drift, "\n")
+	t.Logf("Config of %s drifted from its snapshot in %d leaves:\n%s", s.dut.Name(), len(drift), report)
+	if _, err := WriteOutput(t.Name()+"."+s.dut.Name()+".drift", ".txt", report); err != nil {
+		t.Errorf("Cannot write the config drift of %s: %v", s.dut.Name(), err)
+	}
+
+	t.Logf("Restoring the config of %s from its snapshot.", s.dut.Name())
+	gnmi.Replace(t, s.dut, gnmi.OC().Config(), s.pushable)
+
+	if residual := s.Drift(t); len(residual) > 0 {
+		t.Logf("Config of %s still differs from its snapshot after restore in %d leaves:\n%s", s.dut.Name(), len(residual), strings.Join(residual, "\n"))
+	}
+}
+
+// configDrift returns the leaves that differ between two configs, ignoring the paths
+// that GetDeviceConfig prunes.
+func configDrift(before, after *oc.Root) ([]string, error) {
+	pruned := make([]*oc.Root, 2)
+	for i, config := range []*oc.Root{before, after} {
+		c, err := ygot.DeepCopy(config)
+		if err != nil {
+			return nil, err
+		}
+		pruned[i] = c.(*oc.Root)
+		prunePaths(pruned[i])
+	}
+	// The oc structs prefer the state paths; the shadow paths are the config paths.
+	n, err := ygot.Diff(pruned[0], pruned[1], &ygot.DiffPathOpt{PreferShadowPath: true})
+	if err != nil {
+		return nil, err
+	}
+	var drift []string
+	for _, u := range n.GetUpdate() {
+		drift = append(drift, fmt.Sprintf("%s: %s", pathString(u.GetPath()), valueString(u.GetVal())))
+	}
+	for _, d := range n.GetDelete() {
+		drift = append(drift, "-"+pathString(d))
+	}
+	sort.Strings(drift)
+	return drift, nil
+}
+
+func pathString(p *gpb.Path) string {
+	s, err := ygot.PathToString(p)
+	if err != nil {
+		return p.String()
+	}
+	return s
+}
+
+func valueString(v *gpb.TypedValue) string {
+	return strings.TrimSpace(prototext.MarshalOptions{}.Format(v))
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestConfigDrift(t *testing.T) {
+	base := func() *oc.Root {
+		root := &oc.Root{}
+		root.GetOrCreateInterface("Ethernet1").Description = ygot.String("uplink")
+		root.GetOrCreateInterface("Ethernet2").Mtu = ygot.Uint16(1500)
+		root.GetOrCreateNetworkInstance("DEFAULT")
+		return root
+	}
+
+	tests := []struct {
+		desc   string
+		mutate func(*oc.Root)
+		want   []string
+	}{{
+		desc:   "unchanged",
+		mutate: func(*oc.Root) {},
+	}, {
+		desc: "updated and deleted",
+		mutate: func(root *oc.Root) {
+			root.GetInterface("Ethernet1").Description = ygot.String("downlink")
+			root.GetInterface("Ethernet2").Mtu = nil
+		},
+		want: []string{
+			"-/interfaces/interface[name=Ethernet2]/config/mtu",
+			`/interfaces/interface[name=Ethernet1]/config/description: string_val:"downlink"`,
+		},
+	}, {
+		desc: "pruned paths ignored",
+		mutate: func(root *oc.Root) {
+			root.GetNetworkInstance("DEFAULT").GetOrCreateFdb().MacLearning = ygot.Bool(true)
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			before := base()
+			after := base()
+			test.mutate(after)
+			got, err := configDrift(before, after)
+			if err != nil {
+				t.Fatalf("configDrift got error: %v", err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("configDrift got unexpected drift (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -33,11 +33,12 @@ import (
 )
 
 // DUTInfo retrieves the vendor, model, and OS version from the device from various
-// OpenConfig paths.
+// OpenConfig paths, and optionally its hardware and software inventory.
 type DUTInfo struct {
-	Vendor string
-	Model  string
-	OSVer  string
+	Vendor    string
+	Model     string
+	OSVer     string
+	Inventory []InventoryItem
 }
 
 // setFromComponentChassis sets DUTInfo from the first component of type CHASSIS.
@@ -246,6 +247,7 @@ func (di *DUTInfo) put(m map[string]string, id string) {
 	if di.OSVer != "" {
 		m[id+".os_version"] = di.OSVer
 	}
+	di.putInventory(m, id, *inventoryLimit)
 }
 
 // NewDUTInfo creates a newly populated DUTInfo.
@@ -260,11 +262,14 @@ func NewDUTInfo(ctx context.Context, gnmic gpb.GNMIClient) (*DUTInfo, error) {
 	di.setFromComponentChassis(ctx, y)
 	di.setFromComponentOS(ctx, y)
 	di.setFromLLDP(ctx, y)
+	di.setInventory(ctx, y)
 	return di, err
 }
 
-// dutsInfo populates the DUT properties for all DUTs in the reservation.
-func dutsInfo(ctx context.Context, m map[string]string, resv *binding.Reservation) {
+// dutsInfo populates the DUT properties for all DUTs in the reservation, and returns
+// the DUTInfo of those that could be queried, keyed by DUT ID.
+func dutsInfo(ctx context.Context, m map[string]string, resv *binding.Reservation) map[string]*DUTInfo {
+	duts := make(map[string]*DUTInfo)
 	for id, dut := range resv.DUTs {
 		gnmic, err := dut.DialGNMI(ctx)
 		if err != nil {
@@ -277,5 +282,7 @@ func dutsInfo(ctx context.Context, m map[string]string, resv *binding.Reservatio
 			continue
 		}
 		dInfo.put(m, id)
+		duts[id] = dInfo
 	}
+	return duts
 }
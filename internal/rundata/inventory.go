@@ -0,0 +1,141 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rundata
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/openconfig/featureprofiles/internal/components"
+	"github.com/openconfig/ondatra/gnmi/oc"
+)
+
+// inventoryKinds maps the types of the components in the inventory to the kind used
+// in their property names.  Components of other types, such as ports and sensors,
+// are left out.
+var inventoryKinds = map[oc.Component_Type_Union]string{
+	oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_CHASSIS:          "chassis",
+	oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_LINECARD:         "linecard",
+	oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_FABRIC:           "fabric",
+	oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_CONTROLLER_CARD:  "controller_card",
+	oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_POWER_SUPPLY:     "power_supply",
+	oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_TRANSCEIVER:      "transceiver",
+	oc.PlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT_OPERATING_SYSTEM: "os",
+	oc.PlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT_SOFTWARE_MODULE:  "package",
+	oc.PlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT_BOOT_LOADER:      "boot_loader",
+	oc.PlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT_BIOS:             "bios",
+}
+
+// maxInventoryValue is the length that inventory values are truncated to.
+const maxInventoryValue = 256
+
+// InventoryItem is a component of the DUT inventory.
+type InventoryItem struct {
+	Kind   string            // Kind of component, e.g. "linecard" or "transceiver".
+	Name   string            // Name of the component.
+	Fields map[string]string // Inventory leaves of the component, e.g. "part_no".
+}
+
+// inventoryItem returns the inventory item of a component, or nil if the component
+// is not of a kind in the inventory.
+func inventoryItem(c *oc.Component) *InventoryItem {
+	kind, ok := inventoryKinds[c.GetType()]
+	if !ok {
+		return nil
+	}
+	item := &InventoryItem{Kind: kind, Name: c.GetName(), Fields: make(map[string]string)}
+	set := func(field string, v *string) {
+		if v != nil && *v != "" {
+			item.Fields[field] = *v
+		}
+	}
+	set("description", c.Description)
+	set("part_no", c.PartNo)
+	set("serial_no", c.SerialNo)
+	set("mfg_name", c.MfgName)
+	set("hardware_version", c.HardwareVersion)
+	set("firmware_version", c.FirmwareVersion)
+	set("software_version", c.SoftwareVersion)
+	if t := c.GetTransceiver(); t != nil {
+		set("vendor", t.Vendor)
+		set("vendor_part", t.VendorPart)
+		set("vendor_rev", t.VendorRev)
+	}
+	return item
+}
+
+// setInventory sets the DUTInfo inventory from the components of the device, ordered
+// by kind and name.  The components whose leaves could not all be looked up are
+// still kept with the leaves that could.
+func (di *DUTInfo) setInventory(ctx context.Context, y components.Y) {
+	if *inventoryLimit <= 0 {
+		return
+	}
+	comps, err := y.Inventory(ctx)
+	if err != nil {
+		glog.ErrorContextf(ctx, "Could not get the complete inventory: %v", err)
+	}
+	di.Inventory = nil
+	for _, c := range comps {
+		if item := inventoryItem(c); item != nil {
+			di.Inventory = append(di.Inventory, *item)
+		}
+	}
+	sort.Slice(di.Inventory, func(i, j int) bool {
+		a, b := di.Inventory[i], di.Inventory[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+	glog.V(2).InfoContextf(ctx, "Found %d inventory components", len(di.Inventory))
+}
+
+// putInventory exports the inventory to a map with the given dut ID, as properties
+// named id.inventory.kind.name.field.  At most limit properties are exported; if
+// there are more, id.inventory.truncated is set to the number left out.  Nothing is
+// exported if limit is not positive.
+func (di *DUTInfo) putInventory(m map[string]string, id string, limit int) {
+	if limit <= 0 {
+		return
+	}
+	var n, left int
+	for _, item := range di.Inventory {
+		prefix := id + ".inventory." + item.Kind + "." + strings.Join(strings.Fields(item.Name), "_") + "."
+		fields := make([]string, 0, len(item.Fields))
+		for field := range item.Fields {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			if n >= limit {
+				left++
+				continue
+			}
+			v := item.Fields[field]
+			if len(v) > maxInventoryValue {
+				v = v[:maxInventoryValue]
+			}
+			m[prefix+field] = v
+			n++
+		}
+	}
+	if left > 0 {
+		m[id+".inventory.truncated"] = strconv.Itoa(left)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rundata
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/featureprofiles/topologies/binding/fakedut"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestNewDUTInfoInventory(t *testing.T) {
+	d := fakedut.New(&binding.Dims{Name: "dut1"})
+	defer d.Close()
+	if err := d.Update(func(root *oc.Root) {
+		c := root.GetOrCreateComponent("Chassis")
+		c.Type = oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_CHASSIS
+		c.MfgName = ygot.String("Yoyodyne Systems")
+		c.PartNo = ygot.String("YY1608")
+		c.SerialNo = ygot.String("SN0001")
+
+		c = root.GetOrCreateComponent("Linecard 1")
+		c.Type = oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_LINECARD
+		c.PartNo = ygot.String("LC-36")
+		c.HardwareVersion = ygot.String("1.2")
+
+		c = root.GetOrCreateComponent("Ethernet1")
+		c.Type = oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_TRANSCEIVER
+		c.FirmwareVersion = ygot.String("3.4")
+		c.GetOrCreateTransceiver().VendorPart = ygot.String("QSFP-100G")
+
+		c = root.GetOrCreateComponent("OS")
+		c.Type = oc.PlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT_OPERATING_SYSTEM
+		c.SoftwareVersion = ygot.String("6.22")
+
+		c = root.GetOrCreateComponent("Fan 1")
+		c.Type = oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_FAN
+		c.PartNo = ygot.String("FAN-1")
+	}); err != nil {
+		t.Fatalf("Could not set up the DUT: %v", err)
+	}
+	gnmic, err := d.DialGNMI(context.Background())
+	if err != nil {
+		t.Fatalf("DialGNMI() got err: %v", err)
+	}
+
+	di, err := NewDUTInfo(context.Background(), gnmic)
+	if err != nil {
+		t.Fatalf("NewDUTInfo() got err: %v", err)
+	}
+	got := make(map[string]string)
+	di.put(got, "dut")
+	want := map[string]string{
+		"dut.vendor.full": "Yoyodyne Systems",
+		"dut.vendor":      "YOYODYNE",
+		"dut.model.full":  "YY1608",
+		"dut.model":       "YY1608",
+		"dut.os_version":  "6.22",
+
+		"dut.inventory.chassis.Chassis.mfg_name":               "Yoyodyne Systems",
+		"dut.inventory.chassis.Chassis.part_no":                "YY1608",
+		"dut.inventory.chassis.Chassis.serial_no":              "SN0001",
+		"dut.inventory.linecard.Linecard_1.part_no":            "LC-36",
+		"dut.inventory.linecard.Linecard_1.hardware_version":   "1.2",
+		"dut.inventory.transceiver.Ethernet1.firmware_version": "3.4",
+		"dut.inventory.transceiver.Ethernet1.vendor_part":      "QSFP-100G",
+		"dut.inventory.os.OS.software_version":                 "6.22",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("di.put -want, +got:\n%s", diff)
+	}
+}
+
+func TestPutInventory(t *testing.T) {
+	di := &DUTInfo{Inventory: []InventoryItem{{
+		Kind:   "linecard",
+		Name:   "Linecard0",
+		Fields: map[string]string{"part_no": "LC-36", "serial_no": strings.Repeat("x", 300)},
+	}, {
+		Kind:   "package",
+		Name:   "pkg a",
+		Fields: map[string]string{"software_version": "1.0"},
+	}}}
+
+	cases := []struct {
+		name  string
+		limit int
+		want  map[string]string
+	}{{
+		name:  "all",
+		limit: 10,
+		want: map[string]string{
+			"dut.inventory.linecard.Linecard0.part_no":     "LC-36",
+			"dut.inventory.linecard.Linecard0.serial_no":   strings.Repeat("x", maxInventoryValue),
+			"dut.inventory.package.pkg_a.software_version": "1.0",
+		},
+	}, {
+		name:  "truncated",
+		limit: 1,
+		want: map[string]string{
+			"dut.inventory.linecard.Linecard0.part_no": "LC-36",
+			"dut.inventory.truncated":                  "2",
+		},
+	}, {
+		name:  "disabled",
+		limit: 0,
+		want:  map[string]string{},
+	}}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := make(map[string]string)
+			di.putInventory(got, "dut", c.limit)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("di.putInventory -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
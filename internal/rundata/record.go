@@ -0,0 +1,180 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rundata
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rpb "github.com/openconfig/featureprofiles/proto/runrecord_go_proto"
+)
+
+// Names of the run record files written by WriteRecord.
+const (
+	RecordJSONFile  = "runrecord.json"
+	RecordProtoFile = "runrecord.binpb"
+)
+
+// Record builds the run record from the properties reported for the run, i.e. those
+// returned by Properties, Timing and deviations.Properties, and from the DUTInfo of the
+// DUTs in the reservation, keyed by DUT ID, as returned by Collect.  Properties that are
+// not part of the record are ignored.  Suite properties that other packages report
+// directly through ondatra.Report(), such as the core file and health check deltas of
+// internal/core, are not part of the record.
+func Record(props map[string]string, duts map[string]*DUTInfo) *rpb.RunRecord {
+	rec := &rpb.RunRecord{
+		Test: &rpb.Test{
+			Uuid:        props["test.uuid"],
+			PlanId:      props["test.plan_id"],
+			Description: props["test.description"],
+			Path:        props["test.path"],
+		},
+		Build: &rpb.Build{
+			GoVersion:   props["build.go_version"],
+			Path:        props["build.path"],
+			MainPath:    props["build.main.path"],
+			MainVersion: props["build.main.version"],
+			MainSum:     props["build.main.sum"],
+		},
+		Git: &rpb.Git{
+			Commit:     props["git.commit"],
+			CommitTime: unixTimestamp(props["git.commit_timestamp"]),
+			Origin:     props["git.origin"],
+			Clean:      props["git.clean"] == "true",
+			Status:     props["git.status"],
+		},
+		Topology:      props["topology"],
+		BeginTime:     unixTimestamp(props["time.begin"]),
+		EndTime:       unixTimestamp(props["time.end"]),
+		KnownIssueUrl: props["known_issue_url"],
+	}
+	for k, v := range props {
+		if setting, ok := strings.CutPrefix(k, "build.settings."); ok {
+			if rec.Build.Settings == nil {
+				rec.Build.Settings = make(map[string]string)
+			}
+			rec.Build.Settings[setting] = v
+		}
+		if name, ok := strings.CutPrefix(k, "deviation."); ok {
+			if rec.Deviations == nil {
+				rec.Deviations = make(map[string]string)
+			}
+			rec.Deviations[name] = v
+		}
+	}
+	ids := make([]string, 0, len(duts))
+	for id := range duts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		rec.Duts = append(rec.Duts, duts[id].record(id, *inventoryLimit))
+	}
+	return rec
+}
+
+// record returns the record of the DUT with the given ID.  Like putInventory, at most
+// limit inventory leaves are kept, but the names and values of the components are kept
+// as reported.
+func (di *DUTInfo) record(id string, limit int) *rpb.DUT {
+	dut := &rpb.DUT{
+		Id:         id,
+		VendorFull: di.Vendor,
+		ModelFull:  di.Model,
+		OsVersion:  di.OSVer,
+	}
+	if di.Vendor != "" {
+		dut.Vendor = di.shortVendor()
+	}
+	if di.Model != "" {
+		dut.Model = di.shortModel()
+	}
+	if limit <= 0 {
+		return dut
+	}
+	n := 0
+	for _, item := range di.Inventory {
+		comp := &rpb.Component{Kind: item.Kind, Name: item.Name, Fields: make(map[string]string)}
+		fields := make([]string, 0, len(item.Fields))
+		for field := range item.Fields {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			if n >= limit {
+				dut.InventoryTruncated = true
+				break
+			}
+			comp.Fields[field] = item.Fields[field]
+			n++
+		}
+		if len(comp.Fields) > 0 {
+			dut.Inventory = append(dut.Inventory, comp)
+		}
+	}
+	return dut
+}
+
+// unixTimestamp converts a time in Unix epoch seconds to a timestamp, or nil if the
+// time is not set or not valid.
+func unixTimestamp(s string) *timestamppb.Timestamp {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(time.Unix(sec, 0))
+}
+
+// Outcome returns the outcome of a run from the exit code of the tests, or
+// OUTCOME_UNSPECIFIED if the tests did not finish.
+func Outcome(exitCode *int) rpb.Outcome {
+	switch {
+	case exitCode == nil:
+		return rpb.Outcome_OUTCOME_UNSPECIFIED
+	case *exitCode == 0:
+		return rpb.Outcome_OUTCOME_PASSED
+	default:
+		return rpb.Outcome_OUTCOME_FAILED
+	}
+}
+
+// WriteRecord writes the run record to the directory, both as JSON and as a binary
+// proto, in the files named by RecordJSONFile and RecordProtoFile.
+func WriteRecord(dir string, rec *rpb.RunRecord) error {
+	js, err := protojson.MarshalOptions{Multiline: true}.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("could not marshal run record to JSON: %w", err)
+	}
+	bin, err := proto.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("could not marshal run record: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, RecordJSONFile), js, 0644); err != nil {
+		return fmt.Errorf("could not write run record: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, RecordProtoFile), bin, 0644); err != nil {
+		return fmt.Errorf("could not write run record: %w", err)
+	}
+	return nil
+}
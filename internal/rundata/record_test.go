@@ -0,0 +1,177 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rundata
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rpb "github.com/openconfig/featureprofiles/proto/runrecord_go_proto"
+)
+
+func TestRecord(t *testing.T) {
+	props := map[string]string{
+		"test.uuid":            "c5f5e5a6-0000-4000-8000-000000000000",
+		"test.plan_id":         "RT-1.1",
+		"test.path":            "feature/bgp/tests/bgp_test",
+		"build.go_version":     "go1.24",
+		"build.main.path":      "github.com/openconfig/featureprofiles",
+		"build.settings.GOOS":  "linux",
+		"git.commit":           "0123abcd",
+		"git.commit_timestamp": "1700000000",
+		"git.clean":            "true",
+		"topology":             "ate:2,dut:2",
+		"known_issue_url":      "https://example.com/issue/1",
+		"time.begin":           "1700000100",
+		"time.end":             "1700000200",
+		"deviation.dut.foo":    "true",
+		"dut.vendor":           "ignored",
+	}
+	longSerial := strings.Repeat("S", maxInventoryValue+1)
+	duts := map[string]*DUTInfo{
+		"dut": {
+			Vendor: "Arista Networks",
+			OSVer:  "4.29.0F",
+			Inventory: []InventoryItem{
+				{Kind: "linecard", Name: "Linecard 1", Fields: map[string]string{"part_no": "LC-36", "serial_no": longSerial}},
+				{Kind: "transceiver", Name: "Ethernet1/1", Fields: map[string]string{"vendor": "ACME"}},
+				{Kind: "transceiver", Name: "Port.1", Fields: map[string]string{"vendor_part": "QSFP"}},
+			},
+		},
+		"dut2": {},
+	}
+	got := Record(props, duts)
+	want := &rpb.RunRecord{
+		Test: &rpb.Test{
+			Uuid:   "c5f5e5a6-0000-4000-8000-000000000000",
+			PlanId: "RT-1.1",
+			Path:   "feature/bgp/tests/bgp_test",
+		},
+		Build: &rpb.Build{
+			GoVersion: "go1.24",
+			MainPath:  "github.com/openconfig/featureprofiles",
+			Settings:  map[string]string{"GOOS": "linux"},
+		},
+		Git: &rpb.Git{
+			Commit:     "0123abcd",
+			CommitTime: timestamppb.New(time.Unix(1700000000, 0)),
+			Clean:      true,
+		},
+		Topology:      "ate:2,dut:2",
+		KnownIssueUrl: "https://example.com/issue/1",
+		BeginTime:     timestamppb.New(time.Unix(1700000100, 0)),
+		EndTime:       timestamppb.New(time.Unix(1700000200, 0)),
+		Deviations:    map[string]string{"dut.foo": "true"},
+		Duts: []*rpb.DUT{{
+			Id:         "dut",
+			Vendor:     "ARISTA",
+			VendorFull: "Arista Networks",
+			OsVersion:  "4.29.0F",
+			Inventory: []*rpb.Component{
+				{Kind: "linecard", Name: "Linecard 1", Fields: map[string]string{"part_no": "LC-36", "serial_no": longSerial}},
+				{Kind: "transceiver", Name: "Ethernet1/1", Fields: map[string]string{"vendor": "ACME"}},
+				{Kind: "transceiver", Name: "Port.1", Fields: map[string]string{"vendor_part": "QSFP"}},
+			},
+		}, {
+			Id: "dut2",
+		}},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Record() got unexpected record (-want +got):\n%s", diff)
+	}
+}
+
+func TestRecordInventoryLimit(t *testing.T) {
+	di := &DUTInfo{
+		Inventory: []InventoryItem{
+			{Kind: "linecard", Name: "Linecard1", Fields: map[string]string{"part_no": "LC-36", "serial_no": "SN1"}},
+			{Kind: "transceiver", Name: "Ethernet1", Fields: map[string]string{"vendor": "ACME"}},
+		},
+	}
+	got := di.record("dut", 1)
+	want := &rpb.DUT{
+		Id: "dut",
+		Inventory: []*rpb.Component{
+			{Kind: "linecard", Name: "Linecard1", Fields: map[string]string{"part_no": "LC-36"}},
+		},
+		InventoryTruncated: true,
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("record() got unexpected record (-want +got):\n%s", diff)
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	zero, one := 0, 1
+	cases := []struct {
+		name     string
+		exitCode *int
+		want     rpb.Outcome
+	}{
+		{"unfinished", nil, rpb.Outcome_OUTCOME_UNSPECIFIED},
+		{"passed", &zero, rpb.Outcome_OUTCOME_PASSED},
+		{"failed", &one, rpb.Outcome_OUTCOME_FAILED},
+	}
+	for _, c := range cases {
+		if got := Outcome(c.exitCode); got != c.want {
+			t.Errorf("Outcome(%s) got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestWriteRecord(t *testing.T) {
+	dir := t.TempDir()
+	rec := &rpb.RunRecord{
+		Test:    &rpb.Test{PlanId: "RT-1.1"},
+		Duts:    []*rpb.DUT{{Id: "dut", Vendor: "ARISTA"}},
+		Outcome: rpb.Outcome_OUTCOME_PASSED,
+	}
+	if err := WriteRecord(dir, rec); err != nil {
+		t.Fatalf("WriteRecord() got err: %v", err)
+	}
+
+	js, err := os.ReadFile(filepath.Join(dir, RecordJSONFile))
+	if err != nil {
+		t.Fatalf("Could not read JSON record: %v", err)
+	}
+	gotJSON := &rpb.RunRecord{}
+	if err := protojson.Unmarshal(js, gotJSON); err != nil {
+		t.Fatalf("Could not parse JSON record: %v", err)
+	}
+	if diff := cmp.Diff(rec, gotJSON, protocmp.Transform()); diff != "" {
+		t.Errorf("JSON record (-want +got):\n%s", diff)
+	}
+
+	bin, err := os.ReadFile(filepath.Join(dir, RecordProtoFile))
+	if err != nil {
+		t.Fatalf("Could not read proto record: %v", err)
+	}
+	gotProto := &rpb.RunRecord{}
+	if err := proto.Unmarshal(bin, gotProto); err != nil {
+		t.Fatalf("Could not parse proto record: %v", err)
+	}
+	if diff := cmp.Diff(rec, gotProto, protocmp.Transform()); diff != "" {
+		t.Errorf("Proto record (-want +got):\n%s", diff)
+	}
+}
@@ -47,6 +47,20 @@
 //   - dut.vendor - the vendor of the DUT.
 //   - dut.model - the vendor model name of the DUT.
 //   - dut.os_version - the OS version running on the DUT.
+//   - dut.inventory.kind.name.field - a leaf of a component in the inventory of the DUT,
+//     where kind is one of chassis, linecard, fabric, controller_card, power_supply,
+//     transceiver, os, package, boot_loader or bios; name is the component name with
+//     whitespace replaced by underscores; and field is one of description, part_no,
+//     serial_no, mfg_name, hardware_version, firmware_version, software_version, or
+//     for transceivers vendor, vendor_part and vendor_rev, e.g.
+//     "dut.inventory.transceiver.Ethernet1.vendor_part".  Values are truncated to
+//     256 characters, and at most -dut_inventory_limit are reported per DUT.
+//   - dut.inventory.truncated - the number of inventory properties left out because
+//     of -dut_inventory_limit, if any.
+//
+// Record converts these properties, along with the timing and the deviations, into
+// a RunRecord proto, and WriteRecord writes it as JSON and binary proto files so that
+// runs can be ingested without parsing the XML results.
 package rundata
 
 import (
@@ -66,6 +80,7 @@ var (
 
 	// flags to disable collecting dut info.
 	collectDUTInfo = flag.Bool("collect_dut_info", true, "This flag specifies if the dut information to be collected before running tests.")
+	inventoryLimit = flag.Int("dut_inventory_limit", 500, "Maximum number of inventory properties to collect per DUT with -collect_dut_info, or 0 to not collect the inventory.")
 
 	// Stub out for unit tests.
 	metadataGetFn = metadata.Get
@@ -96,6 +111,13 @@ func topology(resv *binding.Reservation) string {
 
 // Properties builds the test properties map representing run data.
 func Properties(ctx context.Context, resv *binding.Reservation) map[string]string {
+	m, _ := Collect(ctx, resv)
+	return m
+}
+
+// Collect builds the test properties like Properties, and also returns the DUTInfo
+// of the DUTs they were built from, keyed by DUT ID, for the run record.
+func Collect(ctx context.Context, resv *binding.Reservation) (map[string]string, map[string]*DUTInfo) {
 	md := metadataGetFn()
 
 	m := make(map[string]string)
@@ -115,14 +137,15 @@ func Properties(ctx context.Context, resv *binding.Reservation) map[string]strin
 		m["known_issue_url"] = *knownIssueURL
 	}
 
+	var duts map[string]*DUTInfo
 	if resv != nil {
 		m["topology"] = topology(resv)
 		if *collectDUTInfo {
-			dutsInfo(ctx, m, resv)
+			duts = dutsInfo(ctx, m, resv)
 		}
 	}
 
-	return m
+	return m, duts
 }
 
 var timeBegin = time.Now()
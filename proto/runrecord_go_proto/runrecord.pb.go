@@ -0,0 +1,762 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// runrecord.proto defines the record of a test run, written alongside the
+// test results so that they can be ingested without parsing the XML.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: runrecord.proto
+
+package runrecord_go_proto
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Outcome is the outcome of a test run.
+type Outcome int32
+
+const (
+	Outcome_OUTCOME_UNSPECIFIED Outcome = 0
+	Outcome_OUTCOME_PASSED      Outcome = 1
+	Outcome_OUTCOME_FAILED      Outcome = 2
+)
+
+// Enum value maps for Outcome.
+var (
+	Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "OUTCOME_PASSED",
+		2: "OUTCOME_FAILED",
+	}
+	Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED": 0,
+		"OUTCOME_PASSED":      1,
+		"OUTCOME_FAILED":      2,
+	}
+)
+
+func (x Outcome) Enum() *Outcome {
+	p := new(Outcome)
+	*p = x
+	return p
+}
+
+func (x Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_runrecord_proto_enumTypes[0].Descriptor()
+}
+
+func (Outcome) Type() protoreflect.EnumType {
+	return &file_runrecord_proto_enumTypes[0]
+}
+
+func (x Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Outcome.Descriptor instead.
+func (Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_runrecord_proto_rawDescGZIP(), []int{0}
+}
+
+// RunRecord describes a run of a test.
+type RunRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The test that was run.
+	Test *Test `protobuf:"bytes,1,opt,name=test,proto3" json:"test,omitempty"`
+	// How the test binary was built.
+	Build *Build `protobuf:"bytes,2,opt,name=build,proto3" json:"build,omitempty"`
+	// The state of the Feature Profiles repo that the test was run from.
+	Git *Git `protobuf:"bytes,3,opt,name=git,proto3" json:"git,omitempty"`
+	// A summary of the testbed topology, e.g. "ate:2,dut:2".
+	Topology string `protobuf:"bytes,4,opt,name=topology,proto3" json:"topology,omitempty"`
+	// The DUTs of the reservation, ordered by ID.
+	Duts []*DUT `protobuf:"bytes,5,rep,name=duts,proto3" json:"duts,omitempty"`
+	// When the test started and ended.
+	BeginTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=begin_time,json=beginTime,proto3" json:"begin_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The deviations in effect, keyed by name.  Deviations consulted for a
+	// device are keyed by "<device>.<name>".
+	Deviations map[string]string `protobuf:"bytes,8,rep,name=deviations,proto3" json:"deviations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// URL of the known issue that explains why the test fails, if any.
+	KnownIssueUrl string `protobuf:"bytes,9,opt,name=known_issue_url,json=knownIssueUrl,proto3" json:"known_issue_url,omitempty"`
+	// The outcome of the run.
+	Outcome       Outcome `protobuf:"varint,10,opt,name=outcome,proto3,enum=openconfig.profiles.runrecord.Outcome" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunRecord) Reset() {
+	*x = RunRecord{}
+	mi := &file_runrecord_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRecord) ProtoMessage() {}
+
+func (x *RunRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_runrecord_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRecord.ProtoReflect.Descriptor instead.
+func (*RunRecord) Descriptor() ([]byte, []int) {
+	return file_runrecord_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RunRecord) GetTest() *Test {
+	if x != nil {
+		return x.Test
+	}
+	return nil
+}
+
+func (x *RunRecord) GetBuild() *Build {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *RunRecord) GetGit() *Git {
+	if x != nil {
+		return x.Git
+	}
+	return nil
+}
+
+func (x *RunRecord) GetTopology() string {
+	if x != nil {
+		return x.Topology
+	}
+	return ""
+}
+
+func (x *RunRecord) GetDuts() []*DUT {
+	if x != nil {
+		return x.Duts
+	}
+	return nil
+}
+
+func (x *RunRecord) GetBeginTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BeginTime
+	}
+	return nil
+}
+
+func (x *RunRecord) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *RunRecord) GetDeviations() map[string]string {
+	if x != nil {
+		return x.Deviations
+	}
+	return nil
+}
+
+func (x *RunRecord) GetKnownIssueUrl() string {
+	if x != nil {
+		return x.KnownIssueUrl
+	}
+	return ""
+}
+
+func (x *RunRecord) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+// Test identifies a test.
+type Test struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Uuid        string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PlanId      string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Package path of the test, relative to the Feature Profiles repo.
+	Path          string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Test) Reset() {
+	*x = Test{}
+	mi := &file_runrecord_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Test) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Test) ProtoMessage() {}
+
+func (x *Test) ProtoReflect() protoreflect.Message {
+	mi := &file_runrecord_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Test.ProtoReflect.Descriptor instead.
+func (*Test) Descriptor() ([]byte, []int) {
+	return file_runrecord_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Test) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Test) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *Test) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Test) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// Build describes how the test binary was built, from its build info.
+type Build struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoVersion     string                 `protobuf:"bytes,1,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	MainPath      string                 `protobuf:"bytes,3,opt,name=main_path,json=mainPath,proto3" json:"main_path,omitempty"`
+	MainVersion   string                 `protobuf:"bytes,4,opt,name=main_version,json=mainVersion,proto3" json:"main_version,omitempty"`
+	MainSum       string                 `protobuf:"bytes,5,opt,name=main_sum,json=mainSum,proto3" json:"main_sum,omitempty"`
+	Settings      map[string]string      `protobuf:"bytes,6,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Build) Reset() {
+	*x = Build{}
+	mi := &file_runrecord_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Build) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Build) ProtoMessage() {}
+
+func (x *Build) ProtoReflect() protoreflect.Message {
+	mi := &file_runrecord_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Build.ProtoReflect.Descriptor instead.
+func (*Build) Descriptor() ([]byte, []int) {
+	return file_runrecord_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Build) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *Build) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Build) GetMainPath() string {
+	if x != nil {
+		return x.MainPath
+	}
+	return ""
+}
+
+func (x *Build) GetMainVersion() string {
+	if x != nil {
+		return x.MainVersion
+	}
+	return ""
+}
+
+func (x *Build) GetMainSum() string {
+	if x != nil {
+		return x.MainSum
+	}
+	return ""
+}
+
+func (x *Build) GetSettings() map[string]string {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Git describes the state of a git working directory.
+type Git struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Commit     string                 `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	CommitTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=commit_time,json=commitTime,proto3" json:"commit_time,omitempty"`
+	Origin     string                 `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Clean      bool                   `protobuf:"varint,4,opt,name=clean,proto3" json:"clean,omitempty"`
+	// The output of git status --short.
+	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Git) Reset() {
+	*x = Git{}
+	mi := &file_runrecord_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Git) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Git) ProtoMessage() {}
+
+func (x *Git) ProtoReflect() protoreflect.Message {
+	mi := &file_runrecord_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Git.ProtoReflect.Descriptor instead.
+func (*Git) Descriptor() ([]byte, []int) {
+	return file_runrecord_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Git) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *Git) GetCommitTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommitTime
+	}
+	return nil
+}
+
+func (x *Git) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *Git) GetClean() bool {
+	if x != nil {
+		return x.Clean
+	}
+	return false
+}
+
+func (x *Git) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// DUT describes a device under test.
+type DUT struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the DUT in the testbed.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Canonical short vendor, e.g. "ARISTA", and the vendor as reported.
+	Vendor     string `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	VendorFull string `protobuf:"bytes,3,opt,name=vendor_full,json=vendorFull,proto3" json:"vendor_full,omitempty"`
+	// Canonical short model and the model as reported.
+	Model     string `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	ModelFull string `protobuf:"bytes,5,opt,name=model_full,json=modelFull,proto3" json:"model_full,omitempty"`
+	OsVersion string `protobuf:"bytes,6,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty"`
+	// Hardware and software components of the DUT.
+	Inventory []*Component `protobuf:"bytes,7,rep,name=inventory,proto3" json:"inventory,omitempty"`
+	// Whether the inventory was truncated to stay within size limits.
+	InventoryTruncated bool `protobuf:"varint,8,opt,name=inventory_truncated,json=inventoryTruncated,proto3" json:"inventory_truncated,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DUT) Reset() {
+	*x = DUT{}
+	mi := &file_runrecord_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DUT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DUT) ProtoMessage() {}
+
+func (x *DUT) ProtoReflect() protoreflect.Message {
+	mi := &file_runrecord_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DUT.ProtoReflect.Descriptor instead.
+func (*DUT) Descriptor() ([]byte, []int) {
+	return file_runrecord_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DUT) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DUT) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *DUT) GetVendorFull() string {
+	if x != nil {
+		return x.VendorFull
+	}
+	return ""
+}
+
+func (x *DUT) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *DUT) GetModelFull() string {
+	if x != nil {
+		return x.ModelFull
+	}
+	return ""
+}
+
+func (x *DUT) GetOsVersion() string {
+	if x != nil {
+		return x.OsVersion
+	}
+	return ""
+}
+
+func (x *DUT) GetInventory() []*Component {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+func (x *DUT) GetInventoryTruncated() bool {
+	if x != nil {
+		return x.InventoryTruncated
+	}
+	return false
+}
+
+// Component is a hardware or software component of a DUT.
+type Component struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of component, e.g. "linecard" or "transceiver".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Inventory leaves of the component, e.g. "part_no".
+	Fields        map[string]string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Component) Reset() {
+	*x = Component{}
+	mi := &file_runrecord_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Component) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Component) ProtoMessage() {}
+
+func (x *Component) ProtoReflect() protoreflect.Message {
+	mi := &file_runrecord_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Component.ProtoReflect.Descriptor instead.
+func (*Component) Descriptor() ([]byte, []int) {
+	return file_runrecord_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Component) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Component) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Component) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+var File_runrecord_proto protoreflect.FileDescriptor
+
+const file_runrecord_proto_rawDesc = "" +
+	"\n" +
+	"\x0frunrecord.proto\x12\x1dopenconfig.profiles.runrecord\x1a\x1fgoogle/protobuf/timestamp.proto\"\xff\x04\n" +
+	"\tRunRecord\x127\n" +
+	"\x04test\x18\x01 \x01(\v2#.openconfig.profiles.runrecord.TestR\x04test\x12:\n" +
+	"\x05build\x18\x02 \x01(\v2$.openconfig.profiles.runrecord.BuildR\x05build\x124\n" +
+	"\x03git\x18\x03 \x01(\v2\".openconfig.profiles.runrecord.GitR\x03git\x12\x1a\n" +
+	"\btopology\x18\x04 \x01(\tR\btopology\x126\n" +
+	"\x04duts\x18\x05 \x03(\v2\".openconfig.profiles.runrecord.DUTR\x04duts\x129\n" +
+	"\n" +
+	"begin_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tbeginTime\x125\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12X\n" +
+	"\n" +
+	"deviations\x18\b \x03(\v28.openconfig.profiles.runrecord.RunRecord.DeviationsEntryR\n" +
+	"deviations\x12&\n" +
+	"\x0fknown_issue_url\x18\t \x01(\tR\rknownIssueUrl\x12@\n" +
+	"\aoutcome\x18\n" +
+	" \x01(\x0e2&.openconfig.profiles.runrecord.OutcomeR\aoutcome\x1a=\n" +
+	"\x0fDeviationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"i\n" +
+	"\x04Test\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\tR\x06planId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\"\xa2\x02\n" +
+	"\x05Build\x12\x1d\n" +
+	"\n" +
+	"go_version\x18\x01 \x01(\tR\tgoVersion\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1b\n" +
+	"\tmain_path\x18\x03 \x01(\tR\bmainPath\x12!\n" +
+	"\fmain_version\x18\x04 \x01(\tR\vmainVersion\x12\x19\n" +
+	"\bmain_sum\x18\x05 \x01(\tR\amainSum\x12N\n" +
+	"\bsettings\x18\x06 \x03(\v22.openconfig.profiles.runrecord.Build.SettingsEntryR\bsettings\x1a;\n" +
+	"\rSettingsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa0\x01\n" +
+	"\x03Git\x12\x16\n" +
+	"\x06commit\x18\x01 \x01(\tR\x06commit\x12;\n" +
+	"\vcommit_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"commitTime\x12\x16\n" +
+	"\x06origin\x18\x03 \x01(\tR\x06origin\x12\x14\n" +
+	"\x05clean\x18\x04 \x01(\bR\x05clean\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"\x9b\x02\n" +
+	"\x03DUT\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06vendor\x18\x02 \x01(\tR\x06vendor\x12\x1f\n" +
+	"\vvendor_full\x18\x03 \x01(\tR\n" +
+	"vendorFull\x12\x14\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\x12\x1d\n" +
+	"\n" +
+	"model_full\x18\x05 \x01(\tR\tmodelFull\x12\x1d\n" +
+	"\n" +
+	"os_version\x18\x06 \x01(\tR\tosVersion\x12F\n" +
+	"\tinventory\x18\a \x03(\v2(.openconfig.profiles.runrecord.ComponentR\tinventory\x12/\n" +
+	"\x13inventory_truncated\x18\b \x01(\bR\x12inventoryTruncated\"\xbc\x01\n" +
+	"\tComponent\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12L\n" +
+	"\x06fields\x18\x03 \x03(\v24.openconfig.profiles.runrecord.Component.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*J\n" +
+	"\aOutcome\x12\x17\n" +
+	"\x13OUTCOME_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eOUTCOME_PASSED\x10\x01\x12\x12\n" +
+	"\x0eOUTCOME_FAILED\x10\x02b\x06proto3"
+
+var (
+	file_runrecord_proto_rawDescOnce sync.Once
+	file_runrecord_proto_rawDescData []byte
+)
+
+func file_runrecord_proto_rawDescGZIP() []byte {
+	file_runrecord_proto_rawDescOnce.Do(func() {
+		file_runrecord_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_runrecord_proto_rawDesc), len(file_runrecord_proto_rawDesc)))
+	})
+	return file_runrecord_proto_rawDescData
+}
+
+var file_runrecord_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_runrecord_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_runrecord_proto_goTypes = []any{
+	(Outcome)(0),                  // 0: openconfig.profiles.runrecord.Outcome
+	(*RunRecord)(nil),             // 1: openconfig.profiles.runrecord.RunRecord
+	(*Test)(nil),                  // 2: openconfig.profiles.runrecord.Test
+	(*Build)(nil),                 // 3: openconfig.profiles.runrecord.Build
+	(*Git)(nil),                   // 4: openconfig.profiles.runrecord.Git
+	(*DUT)(nil),                   // 5: openconfig.profiles.runrecord.DUT
+	(*Component)(nil),             // 6: openconfig.profiles.runrecord.Component
+	nil,                           // 7: openconfig.profiles.runrecord.RunRecord.DeviationsEntry
+	nil,                           // 8: openconfig.profiles.runrecord.Build.SettingsEntry
+	nil,                           // 9: openconfig.profiles.runrecord.Component.FieldsEntry
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+}
+var file_runrecord_proto_depIdxs = []int32{
+	2,  // 0: openconfig.profiles.runrecord.RunRecord.test:type_name -> openconfig.profiles.runrecord.Test
+	3,  // 1: openconfig.profiles.runrecord.RunRecord.build:type_name -> openconfig.profiles.runrecord.Build
+	4,  // 2: openconfig.profiles.runrecord.RunRecord.git:type_name -> openconfig.profiles.runrecord.Git
+	5,  // 3: openconfig.profiles.runrecord.RunRecord.duts:type_name -> openconfig.profiles.runrecord.DUT
+	10, // 4: openconfig.profiles.runrecord.RunRecord.begin_time:type_name -> google.protobuf.Timestamp
+	10, // 5: openconfig.profiles.runrecord.RunRecord.end_time:type_name -> google.protobuf.Timestamp
+	7,  // 6: openconfig.profiles.runrecord.RunRecord.deviations:type_name -> openconfig.profiles.runrecord.RunRecord.DeviationsEntry
+	0,  // 7: openconfig.profiles.runrecord.RunRecord.outcome:type_name -> openconfig.profiles.runrecord.Outcome
+	8,  // 8: openconfig.profiles.runrecord.Build.settings:type_name -> openconfig.profiles.runrecord.Build.SettingsEntry
+	10, // 9: openconfig.profiles.runrecord.Git.commit_time:type_name -> google.protobuf.Timestamp
+	6,  // 10: openconfig.profiles.runrecord.DUT.inventory:type_name -> openconfig.profiles.runrecord.Component
+	9,  // 11: openconfig.profiles.runrecord.Component.fields:type_name -> openconfig.profiles.runrecord.Component.FieldsEntry
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_runrecord_proto_init() }
+func file_runrecord_proto_init() {
+	if File_runrecord_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_runrecord_proto_rawDesc), len(file_runrecord_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_runrecord_proto_goTypes,
+		DependencyIndexes: file_runrecord_proto_depIdxs,
+		EnumInfos:         file_runrecord_proto_enumTypes,
+		MessageInfos:      file_runrecord_proto_msgTypes,
+	}.Build()
+	File_runrecord_proto = out.File
+	file_runrecord_proto_goTypes = nil
+	file_runrecord_proto_depIdxs = nil
+}
@@ -18,6 +18,7 @@ import (
 // testcase carries parsed rundata from different sources to be fixed and checked.
 type testcase struct {
 	markdown *mpb.Metadata // From the README.md.
+	sections []string      // Section headings of the README.md.
 	existing *mpb.Metadata // From the existing metadata proto.
 	fixed    *mpb.Metadata // Fixed rundata to write back, populated by fix().
 }
@@ -43,11 +44,20 @@ func readFile(filename string, fn func(io.Reader) error) error {
 }
 
 func (tc *testcase) readMarkdown(r io.Reader) error {
-	md, err := parseMarkdown(r)
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	md, err := parseMarkdown(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	sections, err := parseSections(bytes.NewReader(data))
 	if err != nil {
 		return err
 	}
 	tc.markdown = md
+	tc.sections = sections
 	return nil
 }
 
@@ -49,6 +49,7 @@ func TestCase_Read(t *testing.T) {
 				PlanId:      "XX-1.1",
 				Description: "Description from markdown",
 			},
+			sections: []string{"Summary", "Procedure"},
 		},
 	}, {
 		desc:         "bad metadata",
@@ -64,6 +65,7 @@ func TestCase_Read(t *testing.T) {
 				PlanId:      "XX-1.1",
 				Description: "Description from markdown",
 			},
+			sections: []string{"Summary", "Procedure"},
 			existing: &mpb.Metadata{
 				Uuid:        "cb772d39-4f2d-41d2-b286-bca33101d575",
 				PlanId:      "XY-1.1",
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// catalogFeature is a feature directory in the test catalogue.
+type catalogFeature struct {
+	Name  string // Feature path without the "feature/" prefix, e.g. "bgp/addpath".
+	Tests []*catalogTest
+}
+
+// catalogTest is a test in the test catalogue, combining the ATE and OTG variants of the
+// same test.
+type catalogTest struct {
+	PlanID      string
+	Description string
+	Testbed     string
+	Variants    []catalogVariant
+	Exceptions  []catalogExceptions // Sorted by vendor.
+}
+
+// catalogVariant is a test package implementing a test.
+type catalogVariant struct {
+	Kind string // Short name of the test kind, e.g. "OTG".
+	Path string // Test package directory relative to the featureprofiles directory.
+}
+
+// catalogExceptions is the number of platform exceptions of a vendor in a test.
+type catalogExceptions struct {
+	Vendor string
+	Count  int
+}
+
+// variantKinds are the short names of the test kinds shown in the catalogue.
+var variantKinds = map[string]string{
+	"ate_tests": "ATE",
+	"kne_tests": "KNE",
+	"otg_tests": "OTG",
+	"tests":     "DUT",
+}
+
+// buildCatalog groups the tests by feature directory, sorted by feature, and then by
+// test plan ID.  The ATE and OTG variants of the same test are combined, taking the
+// rundata from the OTG variant.
+func buildCatalog(featuredir string, ts testsuite) []*catalogFeature {
+	rootdir := filepath.Dir(featuredir)
+	features := map[string]*catalogFeature{}
+	tests := map[string]*catalogTest{} // Maps from the OTG test directory to the test.
+
+	for _, testdir := range sortedByTestPlanID(ts) {
+		reldir, err := filepath.Rel(rootdir, testdir)
+		if err != nil {
+			reldir = testdir
+		}
+		kind := testKind(reldir)
+		variant := catalogVariant{Kind: variantKinds[kind], Path: reldir}
+		if variant.Kind == "" {
+			variant.Kind = kind
+		}
+
+		key := toOTG(reldir)
+		ct := tests[key]
+		if ct == nil {
+			name := strings.TrimPrefix(featureFromTestDir(reldir), featurePrefix)
+			cf := features[name]
+			if cf == nil {
+				cf = &catalogFeature{Name: name}
+				features[name] = cf
+			}
+			ct = &catalogTest{}
+			cf.Tests = append(cf.Tests, ct)
+			tests[key] = ct
+		}
+		ct.Variants = append(ct.Variants, variant)
+		if len(ct.Variants) == 1 || kind == "otg_tests" {
+			ct.setRundata(ts[testdir])
+		}
+	}
+
+	var catalog []*catalogFeature
+	for _, cf := range features {
+		catalog = append(catalog, cf)
+	}
+	sort.Slice(catalog, func(i, j int) bool { return catalog[i].Name < catalog[j].Name })
+	return catalog
+}
+
+// setRundata populates the catalogue test from the rundata of a test case.
+func (ct *catalogTest) setRundata(tc *testcase) {
+	md := tc.existing
+	if md == nil {
+		md = tc.markdown
+	}
+	ct.PlanID = md.GetPlanId()
+	ct.Description = md.GetDescription()
+	ct.Testbed = ""
+	if tb := md.GetTestbed(); tb != 0 {
+		ct.Testbed = strings.TrimPrefix(tb.String(), "TESTBED_")
+	}
+
+	counts := map[string]int{}
+	for _, pe := range md.GetPlatformExceptions() {
+		counts[pe.GetPlatform().GetVendor().String()]++
+	}
+	ct.Exceptions = nil
+	for vendor, count := range counts {
+		ct.Exceptions = append(ct.Exceptions, catalogExceptions{Vendor: vendor, Count: count})
+	}
+	sort.Slice(ct.Exceptions, func(i, j int) bool { return ct.Exceptions[i].Vendor < ct.Exceptions[j].Vendor })
+}
+
+// catalogTotal returns the number of tests in the catalogue.
+func catalogTotal(catalog []*catalogFeature) int {
+	n := 0
+	for _, cf := range catalog {
+		n += len(cf.Tests)
+	}
+	return n
+}
+
+// markdownCell escapes the text for use in a markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// listMarkdown writes the testsuite as a markdown catalogue with a table of tests for
+// each feature directory.  The links to the tests are relative to the featureprofiles
+// directory.
+func listMarkdown(w io.Writer, featuredir string, ts testsuite) error {
+	catalog := buildCatalog(featuredir, ts)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Test Catalogue\n\n")
+	fmt.Fprintf(&b, "%d tests in %d features.\n", catalogTotal(catalog), len(catalog))
+	for _, cf := range catalog {
+		fmt.Fprintf(&b, "\n## %s\n\n", cf.Name)
+		b.WriteString("| ID | Description | Testbed | Variants | Platform Exceptions |\n")
+		b.WriteString("| -- | ----------- | ------- | -------- | ------------------- |\n")
+		for _, ct := range cf.Tests {
+			var variants, exceptions []string
+			for _, v := range ct.Variants {
+				variants = append(variants, fmt.Sprintf("[%s](%s)", v.Kind, filepath.ToSlash(v.Path)))
+			}
+			for _, e := range ct.Exceptions {
+				exceptions = append(exceptions, fmt.Sprintf("%s: %d", e.Vendor, e.Count))
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n",
+				markdownCell(ct.PlanID), markdownCell(ct.Description), ct.Testbed,
+				strings.Join(variants, ", "), strings.Join(exceptions, ", "))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var catalogHTML = template.Must(template.New("catalog").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Test Catalogue</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #eee; }
+</style>
+</head>
+<body>
+<h1>Test Catalogue</h1>
+<p>{{.Total}} tests in {{len .Features}} features.</p>
+{{range .Features}}
+<h2 id="{{.Name}}">{{.Name}}</h2>
+<table>
+<tr><th>ID</th><th>Description</th><th>Testbed</th><th>Variants</th><th>Platform Exceptions</th></tr>
+{{- range .Tests}}
+<tr><td>{{.PlanID}}</td><td>{{.Description}}</td><td>{{.Testbed}}</td><td>
+{{- range $i, $v := .Variants}}{{if $i}}, {{end}}<a href="{{$v.Path}}">{{$v.Kind}}</a>{{end -}}
+</td><td>
+{{- range $i, $e := .Exceptions}}{{if $i}}, {{end}}{{$e.Vendor}}: {{$e.Count}}{{end -}}
+</td></tr>
+{{- end}}
+</table>
+{{end -}}
+</body>
+</html>
+`))
+
+// listHTML writes the testsuite as a static HTML catalogue with the same content as
+// listMarkdown.
+func listHTML(w io.Writer, featuredir string, ts testsuite) error {
+	catalog := buildCatalog(featuredir, ts)
+	for _, cf := range catalog {
+		for _, ct := range cf.Tests {
+			for i := range ct.Variants {
+				ct.Variants[i].Path = filepath.ToSlash(ct.Variants[i].Path)
+			}
+		}
+	}
+	return catalogHTML.Execute(w, struct {
+		Total    int
+		Features []*catalogFeature
+	}{
+		Total:    catalogTotal(catalog),
+		Features: catalog,
+	})
+}
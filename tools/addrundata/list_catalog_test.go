@@ -0,0 +1,116 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func catalogSuite() testsuite {
+	exception := func(vendor opb.Device_Vendor) *mpb.Metadata_PlatformExceptions {
+		return &mpb.Metadata_PlatformExceptions{
+			Platform: &mpb.Metadata_Platform{Vendor: vendor},
+		}
+	}
+	qux := &mpb.Metadata{
+		PlanId:      "YY-2.1",
+		Description: "Qux Functional Test",
+		Testbed:     mpb.Metadata_TESTBED_DUT_ATE_2LINKS,
+		PlatformExceptions: []*mpb.Metadata_PlatformExceptions{
+			exception(opb.Device_NOKIA),
+			exception(opb.Device_ARISTA),
+			exception(opb.Device_NOKIA),
+		},
+	}
+	return testsuite{
+		"feature/foo/bar/ate_tests/qux_test": &testcase{markdown: qux, existing: qux},
+		"feature/foo/bar/otg_tests/qux_test": &testcase{markdown: qux, existing: qux},
+		"feature/foo/baz/tests/quuz_test": &testcase{
+			markdown: &mpb.Metadata{
+				PlanId:      "XX-1.1",
+				Description: "Quuz | Functional Test",
+			},
+		},
+	}
+}
+
+func TestBuildCatalog(t *testing.T) {
+	want := []*catalogFeature{{
+		Name: "foo/bar",
+		Tests: []*catalogTest{{
+			PlanID:      "YY-2.1",
+			Description: "Qux Functional Test",
+			Testbed:     "DUT_ATE_2LINKS",
+			Variants: []catalogVariant{
+				{Kind: "ATE", Path: "feature/foo/bar/ate_tests/qux_test"},
+				{Kind: "OTG", Path: "feature/foo/bar/otg_tests/qux_test"},
+			},
+			Exceptions: []catalogExceptions{
+				{Vendor: "ARISTA", Count: 1},
+				{Vendor: "NOKIA", Count: 2},
+			},
+		}},
+	}, {
+		Name: "foo/baz",
+		Tests: []*catalogTest{{
+			PlanID:      "XX-1.1",
+			Description: "Quuz | Functional Test",
+			Variants: []catalogVariant{
+				{Kind: "DUT", Path: "feature/foo/baz/tests/quuz_test"},
+			},
+		}},
+	}}
+
+	got := buildCatalog("", catalogSuite())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("buildCatalog -want,+got:\n%s", diff)
+	}
+}
+
+func TestListMarkdown(t *testing.T) {
+	const want = `# Test Catalogue
+
+2 tests in 2 features.
+
+## foo/bar
+
+| ID | Description | Testbed | Variants | Platform Exceptions |
+| -- | ----------- | ------- | -------- | ------------------- |
+| YY-2.1 | Qux Functional Test | DUT_ATE_2LINKS | [ATE](feature/foo/bar/ate_tests/qux_test), [OTG](feature/foo/bar/otg_tests/qux_test) | ARISTA: 1, NOKIA: 2 |
+
+## foo/baz
+
+| ID | Description | Testbed | Variants | Platform Exceptions |
+| -- | ----------- | ------- | -------- | ------------------- |
+| XX-1.1 | Quuz \| Functional Test |  | [DUT](feature/foo/baz/tests/quuz_test) |  |
+`
+
+	var buf strings.Builder
+	if err := listMarkdown(&buf, "", catalogSuite()); err != nil {
+		t.Fatal("Could not write markdown:", err)
+	}
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("listMarkdown -want,+got:\n%s", diff)
+	}
+}
+
+func TestListHTML(t *testing.T) {
+	var buf strings.Builder
+	if err := listHTML(&buf, "", catalogSuite()); err != nil {
+		t.Fatal("Could not write HTML:", err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"<p>2 tests in 2 features.</p>",
+		`<h2 id="foo/bar">foo/bar</h2>`,
+		`<td><a href="feature/foo/bar/ate_tests/qux_test">ATE</a>, <a href="feature/foo/bar/otg_tests/qux_test">OTG</a></td><td>ARISTA: 1, NOKIA: 2</td></tr>`,
+		"<td>Quuz | Functional Test</td>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("listHTML got:\n%s\nwant it to contain %q", got, want)
+		}
+	}
+}
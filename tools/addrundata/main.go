@@ -11,22 +11,30 @@
 // Test plan ID and the description are extracted from the README.md, whereas the UUID is
 // randomly assigned.  Existing UUID assignments are honored.  ATE and OTG versions of the
 // same test must have the same UUID.
+//
+// The check also requires that test plan IDs are all in testregistry.textproto, and that
+// each README.md has the Summary, Procedure, and OpenConfig Path and RPC Coverage
+// sections (except for those listed in tools/readme_section_exceptions.txt).  With
+// --fix, tests missing from testregistry.textproto are added to it, sorted the same way
+// as tools/sort_testregistry.
 package main
 
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"flag"
 
 	"github.com/golang/glog"
 	"github.com/openconfig/featureprofiles/tools/internal/fpciutil"
+	"github.com/openconfig/featureprofiles/tools/internal/testregistry"
 )
 
 var (
 	dir       = flag.String("dir", "", "Directory to search for tests; if not specified, uses the ancestor 'feature' directory.")
 	fix       = flag.Bool("fix", false, "Update the rundata in tests.  If false, only check if the tests have the most recent rundata.")
-	list      = flag.String("list", "", "List the tests in one of the following formats: csv, json")
+	list      = flag.String("list", "", "List the tests in one of the following formats: csv, json, testtracker, markdown, html")
 	mergejson = flag.String("mergejson", "", "Merge the JSON listing from this JSON file.")
 )
 
@@ -60,6 +68,16 @@ func main() {
 			glog.Exitf("Error writing JSON: %v", err)
 		}
 		return
+	case "markdown":
+		if err := listMarkdown(os.Stdout, featuredir, ts); err != nil {
+			glog.Exitf("Error writing markdown: %v", err)
+		}
+		return
+	case "html":
+		if err := listHTML(os.Stdout, featuredir, ts); err != nil {
+			glog.Exitf("Error writing HTML: %v", err)
+		}
+		return
 	case "testtracker":
 		if err := listTestTracker(os.Stdout, *mergejson, featuredir, ts); err != nil {
 			glog.Exitf("Error writing TestTracker: %v", err)
@@ -69,13 +87,30 @@ func main() {
 		glog.Exitf("Unknown listing format: %s", *list)
 	}
 
+	regfile := filepath.Join(filepath.Dir(featuredir), registryFile)
+	reg, err := testregistry.Read(regfile)
+	if err != nil {
+		glog.Exitf("Unable to read test registry: %v", err)
+	}
+
 	if !*fix {
-		if ok := ts.check(featuredir); !ok {
+		ok := ts.check(featuredir)
+		if !ts.checkRegistry(featuredir, reg) {
+			ok = false
+		}
+		if !ok {
 			glog.Exitf("Rundata check found problems.  Please run: go run ./tools/addrundata --fix")
 		}
+		if !ts.checkSections(featuredir) {
+			glog.Exitf("README.md check found problems.  Please add the missing sections.")
+		}
 		return
 	}
 
+	if ok := ts.checkSections(featuredir); !ok {
+		glog.Errorf("README.md check found problems that cannot be fixed automatically.")
+	}
+
 	if ok := ts.check(featuredir); !ok {
 		glog.Errorf("Rundata check found problems.  Will try to apply fixes.")
 	}
@@ -91,4 +126,11 @@ func main() {
 	default:
 		glog.Exitf("Failed to update rundata: %v", err)
 	}
+
+	if reg, n := ts.fixRegistry(featuredir, reg); n > 0 {
+		if err := testregistry.Write(regfile, reg); err != nil {
+			glog.Exitf("Failed to update test registry: %v", err)
+		}
+		fmt.Fprintf(os.Stderr, "Added %d tests to %s.\n", n, registryFile)
+	}
 }
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"path/filepath"
+	"sort"
+
+	tpb "github.com/openconfig/featureprofiles/proto/testregistry_go_proto"
+	"github.com/openconfig/featureprofiles/tools/internal/testregistry"
+)
+
+// registryFile is the test registry relative to the featureprofiles directory.
+const registryFile = "testregistry.textproto"
+
+// unregistered returns the tests whose test plan IDs are missing from the registry, in
+// test plan ID order.  Tests with the same test plan ID, such as the ATE and OTG
+// versions of the same test, are only returned once, preferring the OTG test.
+func (ts testsuite) unregistered(reg *tpb.TestRegistry) []string {
+	registered := map[string]bool{}
+	for _, t := range reg.GetTest() {
+		registered[t.GetId()] = true
+	}
+
+	var ids []string
+	testdirs := map[string]string{} // Maps from test plan ID to testdir.
+	for _, testdir := range ts.testdirs() {
+		tc := ts[testdir]
+		if tc.markdown == nil || tc.markdown.PlanId == "" || registered[tc.markdown.PlanId] {
+			continue
+		}
+		id := tc.markdown.PlanId
+		if _, ok := testdirs[id]; !ok {
+			ids = append(ids, id)
+		}
+		if _, ok := testdirs[id]; !ok || testKind(testdir) == "otg_tests" {
+			testdirs[id] = testdir
+		}
+	}
+	sort.Strings(ids)
+	var unregistered []string
+	for _, id := range ids {
+		unregistered = append(unregistered, testdirs[id])
+	}
+	return unregistered
+}
+
+// checkRegistry checks that the test plan IDs of all tests are in the registry.
+// Returns a boolean whether the check was successful.  Errors are logged.
+func (ts testsuite) checkRegistry(featuredir string, reg *tpb.TestRegistry) (ok bool) {
+	ok = true
+	for _, testdir := range ts.unregistered(reg) {
+		reldir, err := filepath.Rel(filepath.Dir(featuredir), testdir)
+		if err != nil {
+			reldir = testdir
+		}
+		errorf("Test plan ID %s of %s is missing from %s", ts[testdir].markdown.PlanId, reldir, registryFile)
+		ok = false
+	}
+	return ok
+}
+
+// fixRegistry returns the registry with the missing tests added, sorted the same way as
+// tools/sort_testregistry, and the number of tests added.
+func (ts testsuite) fixRegistry(featuredir string, reg *tpb.TestRegistry) (*tpb.TestRegistry, int) {
+	unregistered := ts.unregistered(reg)
+	if len(unregistered) == 0 {
+		return reg, 0
+	}
+	for _, testdir := range unregistered {
+		reldir, err := filepath.Rel(filepath.Dir(featuredir), testdir)
+		if err != nil {
+			reldir = testdir
+		}
+		md := ts[testdir].markdown
+		reg.Test = append(reg.Test, &tpb.Test{
+			Id:          md.PlanId,
+			Description: md.Description,
+			Readme:      []string{testregistry.READMEURLPrefix + filepath.ToSlash(reldir) + "/README.md"},
+		})
+	}
+	return testregistry.Sort(reg), len(unregistered)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+	tpb "github.com/openconfig/featureprofiles/proto/testregistry_go_proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestSuite_Registry(t *testing.T) {
+	newCase := func(planID, desc string) *testcase {
+		return &testcase{markdown: &mpb.Metadata{PlanId: planID, Description: desc}}
+	}
+	ts := testsuite{
+		"/fp/feature/foo/bar/ate_tests/qux_test": newCase("XX-2.1", "Qux Functional Test"),
+		"/fp/feature/foo/bar/otg_tests/qux_test": newCase("XX-2.1", "Qux Functional Test"),
+		"/fp/feature/foo/bar/tests/quuz_test":    newCase("XX-2.2", "Quuz Functional Test"),
+		"/fp/feature/foo/bar/tests/corge_test":   newCase("XX-1.1", "Corge Functional Test"),
+	}
+	reg := &tpb.TestRegistry{
+		Name: "Test Registry",
+		Test: []*tpb.Test{
+			{Id: "XX-2.2", Description: "Quuz Functional Test"},
+			{Id: "XX-0.1", Description: "Unimplemented Test"},
+		},
+	}
+
+	if ok := ts.checkRegistry("/fp/feature", reg); ok {
+		t.Errorf("checkRegistry got ok %v, want %v", ok, false)
+	}
+
+	got, n := ts.fixRegistry("/fp/feature", reg)
+	if n != 2 {
+		t.Errorf("fixRegistry got %d tests added, want 2", n)
+	}
+	want := &tpb.TestRegistry{
+		Name: "Test Registry",
+		Test: []*tpb.Test{{
+			Id:          "XX-0.1",
+			Description: "Unimplemented Test",
+		}, {
+			Id:          "XX-1.1",
+			Description: "Corge Functional Test",
+			Readme:      []string{"https://github.com/openconfig/featureprofiles/blob/main/feature/foo/bar/tests/corge_test/README.md"},
+		}, {
+			Id:          "XX-2.1",
+			Description: "Qux Functional Test",
+			Readme:      []string{"https://github.com/openconfig/featureprofiles/blob/main/feature/foo/bar/otg_tests/qux_test/README.md"},
+		}, {
+			Id:          "XX-2.2",
+			Description: "Quuz Functional Test",
+		}},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("fixRegistry -want,+got:\n%s", diff)
+	}
+
+	if ok := ts.checkRegistry("/fp/feature", got); !ok {
+		t.Errorf("checkRegistry after fix got ok %v, want %v", ok, true)
+	}
+	if _, n := ts.fixRegistry("/fp/feature", got); n != 0 {
+		t.Errorf("fixRegistry after fix got %d tests added, want 0", n)
+	}
+}
@@ -35,6 +35,58 @@ func parseMarkdown(r io.Reader) (*mpb.Metadata, error) {
 	}, nil
 }
 
+// sectionRE matches a section heading below the title: `## Procedure`
+var sectionRE = regexp.MustCompile(`^##+\s+(.*)`)
+
+// parseSections reads the section headings from README.md.  Headings inside fenced
+// code blocks are ignored.
+func parseSections(r io.Reader) ([]string, error) {
+	var sections []string
+	fenced := false
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if strings.HasPrefix(line, "```") {
+			fenced = !fenced
+			continue
+		}
+		if fenced {
+			continue
+		}
+		if m := sectionRE.FindStringSubmatch(line); m != nil {
+			sections = append(sections, strings.TrimSpace(m[1]))
+		}
+	}
+	return sections, sc.Err()
+}
+
+// requiredSections are the sections that every test README.md must have.
+var requiredSections = []string{
+	"Summary",
+	"Procedure",
+	"OpenConfig Path and RPC Coverage",
+}
+
+// missingSections returns the required sections that are not found in the section
+// headings.  A section is found if any heading contains its name, ignoring case, so
+// that e.g. "Test Procedure" counts as "Procedure".
+func missingSections(sections []string) []string {
+	var missing []string
+	for _, want := range requiredSections {
+		found := false
+		for _, got := range sections {
+			if strings.Contains(strings.ToLower(got), strings.ToLower(want)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, want)
+		}
+	}
+	return missing
+}
+
 // parseProto reads metadata from a textproto.
 func parseProto(r io.Reader) (*mpb.Metadata, error) {
 	bytes, err := io.ReadAll(r)
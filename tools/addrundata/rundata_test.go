@@ -47,6 +47,42 @@ func TestParseMarkdown(t *testing.T) {
 	}
 }
 
+func TestParseSections(t *testing.T) {
+	const data = "# XX-1.1: Foo Functional Test\n\n" +
+		"## Summary\n\n" +
+		"```\n## Not a heading\n```\n\n" +
+		"### Test Procedure\n\n" +
+		"## OpenConfig Path and RPC Coverage  \n"
+	got, err := parseSections(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"Summary", "Test Procedure", "OpenConfig Path and RPC Coverage"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseSections -want,+got:\n%s", diff)
+	}
+}
+
+func TestMissingSections(t *testing.T) {
+	cases := []struct {
+		name     string
+		sections []string
+		want     []string
+	}{
+		{name: "none", want: requiredSections},
+		{name: "all", sections: []string{"Summary", "Test procedure", "OpenConfig Path and RPC Coverage"}},
+		{name: "some", sections: []string{"summary", "Config Parameter Coverage"}, want: []string{"Procedure", "OpenConfig Path and RPC Coverage"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := missingSections(c.sections)
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("missingSections -want,+got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestWriteProto(t *testing.T) {
 	want := &mpb.Metadata{
 		Uuid:        "123e4567-e89b-42d3-8456-426614174000",
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -62,6 +63,17 @@ func validPath(testDir string) bool {
 // it.
 type testsuite map[string]*testcase
 
+// testdirs returns the test package directories in sorted order, so that problems are
+// reported deterministically.
+func (ts testsuite) testdirs() []string {
+	testdirs := make([]string, 0, len(ts))
+	for testdir := range ts {
+		testdirs = append(testdirs, testdir)
+	}
+	sort.Strings(testdirs)
+	return testdirs
+}
+
 // read populates the testsuite from a feature root.  Returns a boolean whether the read
 // was successful.  Errors are logged.
 func (ts testsuite) read(featuredir string) (ok bool) {
@@ -212,8 +224,8 @@ func (ts testsuite) checkDuplicate(what string, keyfn func(tc *testcase) string)
 		ok = true
 		wants := map[string]string{} // Maps from key to testdir.
 
-		for got, tc := range ts {
-			key := keyfn(tc)
+		for _, got := range ts.testdirs() {
+			key := keyfn(ts[got])
 			if key == "" {
 				errorf("Skipping check for duplicate %s due to missing value: %s", what, got)
 				continue
@@ -236,6 +248,43 @@ func (ts testsuite) checkDuplicate(what string, keyfn func(tc *testcase) string)
 	return fn
 }
 
+// sectionExceptionsFile lists the README.md files, relative to the featureprofiles
+// directory, that predate the check for required sections.
+const sectionExceptionsFile = "tools/readme_section_exceptions.txt"
+
+// checkSections checks that the README.md of each test has the required sections,
+// except for those listed in the section exceptions file.  Returns a boolean whether
+// the check was successful.  Errors are logged.
+func (ts testsuite) checkSections(featuredir string) (ok bool) {
+	ok = true
+	parentdir := filepath.Dir(featuredir)
+	exceptions, err := getNonTestREADMEs(parentdir, sectionExceptionsFile)
+	if err != nil && !os.IsNotExist(err) {
+		errorf("Error reading %s: %v", sectionExceptionsFile, err)
+		return false
+	}
+
+	for _, testdir := range ts.testdirs() {
+		tc := ts[testdir]
+		readme := filepath.Join(testdir, "README.md")
+		if tc.markdown == nil || exceptions[readme] {
+			continue
+		}
+		missing := missingSections(tc.sections)
+		if len(missing) == 0 {
+			continue
+		}
+		relpath, err := filepath.Rel(parentdir, readme)
+		if err != nil {
+			relpath = readme
+		}
+		errorf("Missing sections in %s: %s", relpath, strings.Join(missing, ", "))
+		ok = false
+	}
+
+	return ok
+}
+
 // checkATEOTG ensures that ATE and OTG versions of the same test have the same rundata.
 func (ts testsuite) checkATEOTG() (ok bool) {
 	ok = true
@@ -222,6 +222,71 @@ func TestSuite_Check(t *testing.T) {
 	}
 }
 
+func TestSuite_CheckSections(t *testing.T) {
+	parentdir := t.TempDir()
+	featuredir := filepath.Join(parentdir, "feature")
+	exceptions := "feature/foo/bar/tests/old_test/README.md\n"
+	if err := os.MkdirAll(filepath.Join(parentdir, "tools"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	md := &mpb.Metadata{PlanId: "XX-2.1", Description: "Qux Functional Test"}
+	complete := &testcase{
+		markdown: md,
+		sections: []string{"Summary", "Test Procedure", "OpenConfig Path and RPC Coverage"},
+	}
+	incomplete := &testcase{
+		markdown: md,
+		sections: []string{"Summary"},
+	}
+
+	wants := []struct {
+		name       string
+		ts         testsuite
+		exceptions string
+		ok         bool
+	}{{
+		name: "Complete",
+		ts: testsuite{
+			filepath.Join(featuredir, "foo/bar/tests/qux_test"): complete,
+		},
+		ok: true,
+	}, {
+		name: "Incomplete",
+		ts: testsuite{
+			filepath.Join(featuredir, "foo/bar/tests/qux_test"): incomplete,
+		},
+		ok: false,
+	}, {
+		name: "IncompleteException",
+		ts: testsuite{
+			filepath.Join(featuredir, "foo/bar/tests/old_test"): incomplete,
+		},
+		exceptions: exceptions,
+		ok:         true,
+	}, {
+		name: "IncompleteNotException",
+		ts: testsuite{
+			filepath.Join(featuredir, "foo/bar/tests/old_test"): complete,
+			filepath.Join(featuredir, "foo/bar/tests/qux_test"): incomplete,
+		},
+		exceptions: exceptions,
+		ok:         false,
+	}}
+
+	for _, want := range wants {
+		t.Run(want.name, func(t *testing.T) {
+			if err := os.WriteFile(filepath.Join(parentdir, sectionExceptionsFile), []byte(want.exceptions), 0600); err != nil {
+				t.Fatal(err)
+			}
+			gotok := want.ts.checkSections(featuredir)
+			if gotok != want.ok {
+				t.Errorf("checkSections got ok %v, want %v", gotok, want.ok)
+			}
+		})
+	}
+}
+
 func TestCheckGoTestFilePackageName(t *testing.T) {
 	tests := []struct {
 		desc        string
@@ -0,0 +1,294 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// accessor describes a hand-written accessor function in the deviations package.
+type accessor struct {
+	Name string
+	// File is the base name of the file that defines the accessor.
+	File string
+	// Doc is the doc comment text.
+	Doc string
+	// ResultType is the printed result type of the function.
+	ResultType string
+	// Getters are the Metadata_Deviations getters called by the function.
+	Getters []string
+	// FlagsChecked are the flag names passed to isFlagSet.
+	FlagsChecked []string
+	// Recorded are the deviation names passed to recorded.
+	Recorded []string
+}
+
+// overrideFlag describes a flag declared in byexceptions.go that overrides a deviation.
+type overrideFlag struct {
+	// Name is the flag name, e.g. "deviation_cpu_missing_ancestor".
+	Name string
+	// Var is the Go variable holding the flag value.
+	Var string
+}
+
+// flagPrefix is prepended to a deviation name to form its override flag name.
+const flagPrefix = "deviation_"
+
+// pkg holds the accessors and override flags parsed from the deviations package.
+type pkg struct {
+	accessors []*accessor
+	// flags maps deviation names to their override flags.
+	flags map[string]*overrideFlag
+}
+
+// readPackage parses the non-test Go files in dir.
+func readPackage(dir string) (*pkg, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	p := &pkg{flags: map[string]*overrideFlag{}}
+	for _, filename := range matches {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil || !decl.Name.IsExported() || decl.Body == nil {
+					continue
+				}
+				p.accessors = append(p.accessors, readAccessor(fset, filepath.Base(filename), decl))
+			case *ast.GenDecl:
+				if decl.Tok != token.VAR {
+					continue
+				}
+				for _, spec := range decl.Specs {
+					vs := spec.(*ast.ValueSpec)
+					for i, v := range vs.Values {
+						if name, ok := flagName(v); ok && i < len(vs.Names) {
+							p.flags[strings.TrimPrefix(name, flagPrefix)] = &overrideFlag{Name: name, Var: vs.Names[i].Name}
+						}
+					}
+				}
+			}
+		}
+	}
+	return p, nil
+}
+
+func readAccessor(fset *token.FileSet, file string, fn *ast.FuncDecl) *accessor {
+	a := &accessor{Name: fn.Name.Name, File: file, Doc: fn.Doc.Text()}
+	if fn.Type.Results != nil {
+		var buf bytes.Buffer
+		for i, r := range fn.Type.Results.List {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			printer.Fprint(&buf, fset, r.Type)
+		}
+		a.ResultType = buf.String()
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			if strings.HasPrefix(fun.Sel.Name, "Get") {
+				a.Getters = append(a.Getters, fun.Sel.Name)
+			}
+		case *ast.Ident:
+			switch {
+			case fun.Name == "isFlagSet" && len(call.Args) == 1:
+				if s, ok := stringLit(call.Args[0]); ok {
+					a.FlagsChecked = append(a.FlagsChecked, s)
+				}
+			case fun.Name == "recorded" && len(call.Args) == 3:
+				if s, ok := stringLit(call.Args[1]); ok {
+					a.Recorded = append(a.Recorded, s)
+				}
+			}
+		}
+		return true
+	})
+	return a
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// flagName returns the flag name if the expression is a flag.Xxx("deviation_...", ...)
+// call.
+func flagName(e ast.Expr) (string, bool) {
+	call, ok := e.(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	if x, ok := sel.X.(*ast.Ident); !ok || x.Name != "flag" {
+		return "", false
+	}
+	name, ok := stringLit(call.Args[0])
+	if !ok || !strings.HasPrefix(name, flagPrefix) {
+		return "", false
+	}
+	return name, true
+}
+
+// generate returns the formatted source of accessors for the given fields.
+func generate(fields []*field, flags map[string]*overrideFlag) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, f := range fields {
+		name := accessorName(f.Name)
+		fmt.Fprintf(&buf, "\n// %s returns the %s deviation.\n", name, f.Name)
+		for _, line := range f.Comments {
+			if line == "" {
+				buf.WriteString("//\n")
+				continue
+			}
+			fmt.Fprintf(&buf, "// %s\n", line)
+		}
+		param, typ, lookup := "dut", "DUTDevice", "lookupDUTDeviations"
+		if f.ATE {
+			param, typ, lookup = "ate", "ATEDevice", "lookupATEDeviations"
+		}
+		fmt.Fprintf(&buf, "func %s(%s *ondatra.%s) %s {\n", name, param, typ, f.GoType)
+		if fl, ok := flags[f.Name]; ok {
+			fmt.Fprintf(&buf, "if isFlagSet(%q) {\nreturn recorded(%s.ID(), %q, *%s)\n}\n", fl.Name, param, f.Name, fl.Var)
+		}
+		fmt.Fprintf(&buf, "return recorded(%s.ID(), %q, %s(%s).%s())\n}\n", param, f.Name, lookup, param, f.Getter)
+	}
+	return format.Source(buf.Bytes())
+}
+
+// missing returns the fields that do not have an accessor.
+func missing(fields []*field, p *pkg) []*field {
+	read := map[string]bool{}
+	for _, a := range p.accessors {
+		for _, g := range a.Getters {
+			read[g] = true
+		}
+	}
+	var ret []*field
+	for _, f := range fields {
+		if !read[f.Getter] {
+			ret = append(ret, f)
+		}
+	}
+	return ret
+}
+
+// check returns the disagreements between the deviations package and metadata.proto.
+func check(fields []*field, p *pkg) []string {
+	var problems []string
+	addf := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	byGetter := map[string]*field{}
+	for _, f := range fields {
+		byGetter[f.Getter] = f
+	}
+
+	accessorsOf := map[string][]*accessor{}
+	for _, a := range p.accessors {
+		var read []*field
+		for _, g := range a.Getters {
+			if f, ok := byGetter[g]; ok {
+				read = append(read, f)
+			}
+		}
+		if len(read) == 0 {
+			continue
+		}
+		if len(read) > 1 {
+			addf("%s: %s reads more than one deviation", a.File, a.Name)
+			continue
+		}
+		f := read[0]
+		accessorsOf[f.Name] = append(accessorsOf[f.Name], a)
+
+		if a.ResultType != f.GoType {
+			addf("%s: %s returns %s but deviation %s is %s", a.File, a.Name, a.ResultType, f.Name, f.GoType)
+		}
+		if !strings.HasPrefix(a.Doc, a.Name+" ") {
+			addf("%s: %s should have a doc comment starting with its name", a.File, a.Name)
+		}
+		if len(a.Recorded) == 0 {
+			addf("%s: %s does not record the deviation %s", a.File, a.Name, f.Name)
+		}
+		for _, name := range a.Recorded {
+			if name != f.Name {
+				addf("%s: %s records %q but reads deviation %s", a.File, a.Name, name, f.Name)
+			}
+		}
+		fl, hasFlag := p.flags[f.Name]
+		checksFlag := false
+		for _, name := range a.FlagsChecked {
+			if hasFlag && name == fl.Name {
+				checksFlag = true
+				continue
+			}
+			addf("%s: %s checks flag %q which does not override deviation %s", a.File, a.Name, name, f.Name)
+		}
+		if hasFlag && !checksFlag {
+			addf("%s: %s does not honour the override flag %q", a.File, a.Name, fl.Name)
+		}
+	}
+
+	fieldNames := map[string]bool{}
+	for _, f := range fields {
+		fieldNames[f.Name] = true
+		// A deviation may have more than one accessor, e.g. when an accessor was renamed
+		// and the old name kept for compatibility.
+		if len(accessorsOf[f.Name]) == 0 {
+			addf("deviation %s has no accessor", f.Name)
+		}
+	}
+	for name, fl := range p.flags {
+		if !fieldNames[name] {
+			addf("flag %q does not override any deviation", fl.Name)
+		}
+	}
+
+	sort.Strings(problems)
+	return problems
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAccessorName(t *testing.T) {
+	cases := []struct {
+		name string
+		want string
+	}{
+		{"omit_l2_mtu", "OmitL2MTU"},
+		{"ipv4_missing_enabled", "IPv4MissingEnabled"},
+		{"bgp_tolerance_value", "BGPToleranceValue"},
+		{"require_routed_subinterface_0", "RequireRoutedSubinterface0"},
+		{"ate_ipv6_flow_label_unsupported", "ATEIPv6FlowLabelUnsupported"},
+	}
+	for _, c := range cases {
+		if got := accessorName(c.name); got != c.want {
+			t.Errorf("accessorName(%q) got %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	fields := []*field{{
+		Name:     "omit_l2_mtu",
+		Getter:   "GetOmitL2Mtu",
+		GoType:   "bool",
+		Comments: []string{"Device does not support setting the L2 MTU."},
+	}, {
+		Name:   "ate_port_link_state_operations_unsupported",
+		Getter: "GetAtePortLinkStateOperationsUnsupported",
+		GoType: "bool",
+		ATE:    true,
+	}}
+	flags := map[string]*overrideFlag{
+		"ate_port_link_state_operations_unsupported": {
+			Name: "deviation_ate_port_link_state_operations_unsupported",
+			Var:  "atePortLinkStateOperationsUnsupported",
+		},
+	}
+
+	got, err := generate(fields, flags)
+	if err != nil {
+		t.Fatalf("generate() got error: %v", err)
+	}
+	want := `
+// OmitL2MTU returns the omit_l2_mtu deviation.
+// Device does not support setting the L2 MTU.
+func OmitL2MTU(dut *ondatra.DUTDevice) bool {
+	return recorded(dut.ID(), "omit_l2_mtu", lookupDUTDeviations(dut).GetOmitL2Mtu())
+}
+
+// ATEPortLinkStateOperationsUnsupported returns the ate_port_link_state_operations_unsupported deviation.
+func ATEPortLinkStateOperationsUnsupported(ate *ondatra.ATEDevice) bool {
+	if isFlagSet("deviation_ate_port_link_state_operations_unsupported") {
+		return recorded(ate.ID(), "ate_port_link_state_operations_unsupported", *atePortLinkStateOperationsUnsupported)
+	}
+	return recorded(ate.ID(), "ate_port_link_state_operations_unsupported", lookupATEDeviations(ate).GetAtePortLinkStateOperationsUnsupported())
+}
+`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("generate() -want,+got:\n%s", diff)
+	}
+}
+
+const testPackage = `package deviations
+
+var (
+	cpuMissingAncestor = flag.Bool("deviation_cpu_missing_ancestor", false, "")
+	staleFlag          = flag.Bool("deviation_no_such_deviation", false, "")
+)
+
+// OmitL2MTU returns if device does not support setting the L2 MTU.
+func OmitL2MTU(dut *ondatra.DUTDevice) bool {
+	return recorded(dut.ID(), "omit_l2_mtu", lookupDUTDeviations(dut).GetOmitL2Mtu())
+}
+
+// OmitL2MTUAgain is an alias of OmitL2MTU.
+func OmitL2MTUAgain(dut *ondatra.DUTDevice) bool {
+	return recorded(dut.ID(), "omit_l2_mtu", lookupDUTDeviations(dut).GetOmitL2Mtu())
+}
+
+// Returns the banner delimiter.
+func BannerDelimiter(dut *ondatra.DUTDevice) bool {
+	return recorded(dut.ID(), "banner_delimiter", lookupDUTDeviations(dut).GetBannerDelimiter()) != ""
+}
+
+// CPUMissingAncestor ignores its flag.
+func CPUMissingAncestor(dut *ondatra.DUTDevice) bool {
+	return recorded(dut.ID(), "omit_l2_mtu", lookupDUTDeviations(dut).GetCpuMissingAncestor())
+}
+`
+
+func TestCheck(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "deviations.go"), []byte(testPackage), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p, err := readPackage(dir)
+	if err != nil {
+		t.Fatalf("readPackage() got error: %v", err)
+	}
+
+	fields := []*field{
+		{Name: "omit_l2_mtu", Getter: "GetOmitL2Mtu", GoType: "bool"},
+		{Name: "banner_delimiter", Getter: "GetBannerDelimiter", GoType: "string"},
+		{Name: "cpu_missing_ancestor", Getter: "GetCpuMissingAncestor", GoType: "bool"},
+		{Name: "traceroute_fragmentation", Getter: "GetTracerouteFragmentation", GoType: "bool"},
+	}
+
+	want := []string{
+		`deviation traceroute_fragmentation has no accessor`,
+		`deviations.go: BannerDelimiter returns bool but deviation banner_delimiter is string`,
+		`deviations.go: BannerDelimiter should have a doc comment starting with its name`,
+		`deviations.go: CPUMissingAncestor does not honour the override flag "deviation_cpu_missing_ancestor"`,
+		`deviations.go: CPUMissingAncestor records "omit_l2_mtu" but reads deviation cpu_missing_ancestor`,
+		`flag "deviation_no_such_deviation" does not override any deviation`,
+	}
+	if diff := cmp.Diff(want, check(fields, p)); diff != "" {
+		t.Errorf("check() -want,+got:\n%s", diff)
+	}
+
+	gotMissing := missing(fields, p)
+	if len(gotMissing) != 1 || gotMissing[0].Name != "traceroute_fragmentation" {
+		t.Errorf("missing() got %v, want [traceroute_fragmentation]", gotMissing)
+	}
+}
+
+func TestReadFields(t *testing.T) {
+	fields, err := readFields(filepath.Join("..", "..", "proto", "metadata.proto"))
+	if err != nil {
+		t.Fatalf("readFields() got error: %v", err)
+	}
+	byName := map[string]*field{}
+	for _, f := range fields {
+		byName[f.Name] = f
+	}
+	want := &field{
+		Name:     "ipv4_missing_enabled",
+		Getter:   "GetIpv4MissingEnabled",
+		GoType:   "bool",
+		Comments: []string{"Device does not support interface/ipv4/enabled,", "so suppress configuring this leaf."},
+	}
+	if diff := cmp.Diff(want, byName["ipv4_missing_enabled"]); diff != "" {
+		t.Errorf("readFields() ipv4_missing_enabled -want,+got:\n%s", diff)
+	}
+	if got := byName["hierarchical_weight_resolution_tolerance"].GoType; got != "float64" {
+		t.Errorf("readFields() hierarchical_weight_resolution_tolerance GoType got %q, want float64", got)
+	}
+}
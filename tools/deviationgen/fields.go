@@ -0,0 +1,196 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4"
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+// field describes a field of the Metadata.Deviations message.
+type field struct {
+	// Name is the proto field name, e.g. "omit_l2_mtu".
+	Name string
+	// Getter is the name of the generated Go getter, e.g. "GetOmitL2Mtu".
+	Getter string
+	// GoType is the Go type returned by the getter, e.g. "bool" or "[]string".
+	GoType string
+	// Comments are the leading comment lines of the field in metadata.proto.
+	Comments []string
+	// ATE is true if the deviation applies to traffic generators.
+	ATE bool
+}
+
+// readFields returns the fields of the Metadata.Deviations message in field number
+// order, using the compiled descriptor for the types and the proto source file for the
+// comments.
+func readFields(protoFile string) ([]*field, error) {
+	comments, err := readComments(protoFile)
+	if err != nil {
+		return nil, err
+	}
+
+	getters := map[string]string{}
+	t := reflect.TypeOf(mpb.Metadata_Deviations{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		for _, part := range strings.Split(f.Tag.Get("protobuf"), ",") {
+			if name, ok := strings.CutPrefix(part, "name="); ok {
+				getters[name] = "Get" + f.Name
+			}
+		}
+	}
+
+	var fields []*field
+	fds := (&mpb.Metadata_Deviations{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		name := string(fd.Name())
+		cs, ok := comments[name]
+		if !ok {
+			return nil, fmt.Errorf("deviation %s is not found in %s; is the generated Go code up to date?", name, protoFile)
+		}
+		fields = append(fields, &field{
+			Name:     name,
+			Getter:   getters[name],
+			GoType:   goType(fd),
+			Comments: cs,
+			ATE:      strings.HasPrefix(name, "ate_"),
+		})
+	}
+	return fields, nil
+}
+
+// goType returns the Go type of the getter for a field.
+func goType(fd protoreflect.FieldDescriptor) string {
+	var t string
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		t = "bool"
+	case protoreflect.StringKind:
+		t = "string"
+	case protoreflect.BytesKind:
+		t = "[]byte"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		t = "int32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		t = "uint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		t = "int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		t = "uint64"
+	case protoreflect.FloatKind:
+		t = "float32"
+	case protoreflect.DoubleKind:
+		t = "float64"
+	case protoreflect.EnumKind:
+		// Nested enums are named after their parent, e.g. Metadata_Testbed.
+		full := string(fd.Enum().FullName())
+		full = strings.TrimPrefix(full, string(fd.Enum().ParentFile().Package())+".")
+		t = "mpb." + strings.ReplaceAll(full, ".", "_")
+	default:
+		t = "any"
+	}
+	if fd.IsList() {
+		t = "[]" + t
+	}
+	return t
+}
+
+// readComments parses metadata.proto and returns the leading comment lines of each
+// field of the Metadata.Deviations message.
+func readComments(protoFile string) (map[string][]string, error) {
+	f, err := os.Open(protoFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p, err := protoparser.Parse(f, protoparser.WithFilename(protoFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", protoFile, err)
+	}
+
+	deviations := findMessage(p.ProtoBody, "Metadata", "Deviations")
+	if deviations == nil {
+		return nil, fmt.Errorf("message Metadata.Deviations not found in %s", protoFile)
+	}
+
+	comments := map[string][]string{}
+	for _, v := range deviations.MessageBody {
+		pf, ok := v.(*parser.Field)
+		if !ok {
+			continue
+		}
+		var lines []string
+		for _, c := range pf.Comments {
+			for _, line := range c.Lines() {
+				lines = append(lines, strings.TrimSpace(line))
+			}
+		}
+		comments[pf.FieldName] = lines
+	}
+	return comments, nil
+}
+
+// findMessage returns the message nested at the given path of message names.
+func findMessage(body []parser.Visitee, path ...string) *parser.Message {
+	for _, v := range body {
+		m, ok := v.(*parser.Message)
+		if !ok || m.MessageName != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return m
+		}
+		return findMessage(m.MessageBody, path[1:]...)
+	}
+	return nil
+}
+
+// initialisms are name parts that are written in all caps in accessor names.
+var initialisms = map[string]string{
+	"acl": "ACL", "aft": "AFT", "arp": "ARP", "ate": "ATE", "bfd": "BFD", "bgp": "BGP",
+	"cli": "CLI", "cpu": "CPU", "dscp": "DSCP", "ecmp": "ECMP", "ecn": "ECN", "fib": "FIB",
+	"gnmi": "GNMI", "gnoi": "GNOI", "gnsi": "GNSI", "gribi": "GRIBI", "id": "ID", "ip": "IP",
+	"ipv4": "IPv4", "ipv6": "IPv6", "isis": "ISIS", "lacp": "LACP", "lag": "LAG", "lldp": "LLDP",
+	"mac": "MAC", "mpls": "MPLS", "mtu": "MTU", "oc": "OC", "ospf": "OSPF", "p4rt": "P4RT",
+	"qos": "QoS", "rib": "RIB", "tcp": "TCP", "ttl": "TTL", "udp": "UDP", "vrf": "VRF",
+}
+
+// accessorName returns the default accessor name for a proto field name, e.g.
+// "omit_l2_mtu" becomes "OmitL2MTU".
+func accessorName(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		if s, ok := initialisms[part]; ok {
+			b.WriteString(s)
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
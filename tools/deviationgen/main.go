@@ -0,0 +1,109 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command deviationgen generates the accessors in internal/deviations from the
+// Deviations message in proto/metadata.proto.
+//
+// Accessors are generated for every deviation that does not have one yet, using the
+// comments of the proto field as the doc comment.  Deviations that can be overridden by
+// a flag in byexceptions.go honour the flag.  Existing accessors are left untouched so
+// that they may implement a default different from the proto default.
+//
+// There are three modes of operation:
+//
+//	go run ./tools/deviationgen          # print the missing accessors
+//	go run ./tools/deviationgen -w       # append the missing accessors to deviations.go
+//	go run ./tools/deviationgen -check   # fail if deviations.go and metadata.proto disagree
+//
+// The generated Go code for metadata.proto must be up to date before running the tool.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	log "github.com/golang/glog"
+	"github.com/openconfig/featureprofiles/tools/internal/fpciutil"
+)
+
+var (
+	root    = flag.String("root", "", "Root of the featureprofiles repository; if not specified, uses the parent of the ancestor 'feature' directory.")
+	checkOp = flag.Bool("check", false, "Only check that the accessors agree with metadata.proto.")
+	write   = flag.Bool("w", false, "Append the missing accessors to deviations.go instead of printing them.")
+)
+
+func main() {
+	flag.Parse()
+
+	rootdir := *root
+	if rootdir == "" {
+		featuredir, err := fpciutil.FeatureDir()
+		if err != nil {
+			log.Exitf("Unable to locate feature root: %v", err)
+		}
+		rootdir = filepath.Dir(featuredir)
+	}
+	protoFile := filepath.Join(rootdir, "proto", "metadata.proto")
+	pkgDir := filepath.Join(rootdir, "internal", "deviations")
+
+	fields, err := readFields(protoFile)
+	if err != nil {
+		log.Exitf("Unable to read deviations: %v", err)
+	}
+	p, err := readPackage(pkgDir)
+	if err != nil {
+		log.Exitf("Unable to read accessors: %v", err)
+	}
+
+	if *checkOp {
+		problems := check(fields, p)
+		for _, problem := range problems {
+			fmt.Fprintln(os.Stderr, problem)
+		}
+		if len(problems) > 0 {
+			log.Exitf("Found %d problems in %s.  Please run: go run ./tools/deviationgen -w", len(problems), pkgDir)
+		}
+		return
+	}
+
+	src, err := generate(missing(fields, p), p.flags)
+	if err != nil {
+		log.Exitf("Unable to generate accessors: %v", err)
+	}
+	if !*write {
+		os.Stdout.Write(src)
+		return
+	}
+	if len(bytes.TrimSpace(src)) == 0 {
+		fmt.Fprintln(os.Stderr, "Everything already up to date.")
+		return
+	}
+
+	filename := filepath.Join(pkgDir, "deviations.go")
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		log.Exitf("Unable to open %s: %v", filename, err)
+	}
+	if _, err := f.Write(src); err != nil {
+		f.Close()
+		log.Exitf("Unable to write %s: %v", filename, err)
+	}
+	if err := f.Close(); err != nil {
+		log.Exitf("Unable to write %s: %v", filename, err)
+	}
+	fmt.Fprintf(os.Stderr, "Accessors are successfully added to %s.\n", filename)
+}
@@ -0,0 +1,450 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/featureprofiles/internal/deviations"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+const deviationsImportPath = "github.com/openconfig/featureprofiles/internal/deviations"
+
+// goFieldToProto maps the Go struct field names of the generated Metadata_Deviations
+// message to their proto field names, e.g. "OmitL2Mtu" to "omit_l2_mtu".
+func goFieldToProto() map[string]string {
+	m := map[string]string{}
+	t := reflect.TypeOf(mpb.Metadata_Deviations{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		for _, part := range strings.Split(f.Tag.Get("protobuf"), ",") {
+			if name, ok := strings.CutPrefix(part, "name="); ok {
+				m[f.Name] = name
+			}
+		}
+	}
+	return m
+}
+
+// readAccessors parses the Go files of the deviations package and returns a map from
+// each exported accessor to the proto field of Metadata.Deviations that it reads.
+func readAccessors(dir string) (map[string]string, error) {
+	fields := goFieldToProto()
+	fset := token.NewFileSet()
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	accessors := map[string]string{}
+	for _, filename := range matches {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filename, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || !fn.Name.IsExported() || fn.Body == nil {
+				continue
+			}
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				sel, ok := n.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				if name, ok := fields[strings.TrimPrefix(sel.Sel.Name, "Get")]; ok && strings.HasPrefix(sel.Sel.Name, "Get") {
+					accessors[fn.Name.Name] = name
+					return false
+				}
+				return true
+			})
+		}
+	}
+	if len(accessors) == 0 {
+		return nil, fmt.Errorf("no deviation accessors found in %s", dir)
+	}
+	return accessors, nil
+}
+
+// readReferences walks root for Go files that import the deviations package, and
+// returns the exported identifiers of the package referenced by each directory.
+func readReferences(root string) (map[string]map[string]bool, error) {
+	refs := map[string]map[string]bool{}
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		pkgName := ""
+		for _, imp := range f.Imports {
+			if p, _ := strconv.Unquote(imp.Path.Value); p != deviationsImportPath {
+				continue
+			}
+			pkgName = "deviations"
+			if imp.Name != nil {
+				pkgName = imp.Name.Name
+			}
+		}
+		if pkgName == "" || pkgName == "_" {
+			return nil
+		}
+		dir := filepath.Dir(path)
+		ast.Inspect(f, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == pkgName && sel.Sel.IsExported() {
+				if refs[dir] == nil {
+					refs[dir] = map[string]bool{}
+				}
+				refs[dir][sel.Sel.Name] = true
+			}
+			return true
+		})
+		return nil
+	})
+	return refs, err
+}
+
+// readMetadata walks root for metadata.textproto files and returns them keyed by
+// directory.
+func readMetadata(root string) (map[string]*mpb.Metadata, error) {
+	mds := map[string]*mpb.Metadata{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || d.Name() != "metadata.textproto" {
+			return nil
+		}
+		bytes, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		md := new(mpb.Metadata)
+		if err := prototext.Unmarshal(bytes, md); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		mds[filepath.Dir(path)] = md
+		return nil
+	})
+	return mds, err
+}
+
+// deviationValues returns the deviations that are set to a non-default value, keyed by
+// the proto field name.
+func deviationValues(d *mpb.Metadata_Deviations) map[string]string {
+	values := map[string]string{}
+	d.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		values[string(fd.Name())] = formatValue(fd, v)
+		return true
+	})
+	return values
+}
+
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if fd.IsList() {
+		var parts []string
+		l := v.List()
+		for i := 0; i < l.Len(); i++ {
+			parts = append(parts, formatScalar(fd, l.Get(i)))
+		}
+		return strings.Join(parts, ";")
+	}
+	return formatScalar(fd, v)
+}
+
+func formatScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if fd.Kind() == protoreflect.EnumKind {
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+	}
+	return v.String()
+}
+
+// testUsage describes the deviations of a single test.
+type testUsage struct {
+	// Path is the test directory relative to the repository root.
+	Path string `json:"path"`
+	// PlanID is the test plan ID from metadata.textproto.
+	PlanID string `json:"plan_id,omitempty"`
+	// Referenced are the deviations read by the test code.
+	Referenced []string `json:"referenced,omitempty"`
+	// Set maps vendor to the deviations set in platform_exceptions and their values.
+	Set map[string]map[string]string `json:"set,omitempty"`
+}
+
+// vendorUsage summarizes the deviations carried by a vendor.
+type vendorUsage struct {
+	Vendor string `json:"vendor"`
+	// Settings is the number of deviation values set across all tests.
+	Settings int `json:"settings"`
+	// Tests is the number of tests with at least one deviation set.
+	Tests int `json:"tests"`
+	// Deviations is the number of distinct deviations set.
+	Deviations int `json:"deviations"`
+}
+
+// unreadSetting is a deviation set in metadata.textproto that is not read by the test
+// nor by any library package.
+type unreadSetting struct {
+	Path      string `json:"path"`
+	Vendor    string `json:"vendor"`
+	Deviation string `json:"deviation"`
+}
+
+// report is the result of the deviation usage analysis.
+type report struct {
+	// Accessors maps proto field names to the accessor functions reading them.
+	Accessors map[string]string `json:"accessors"`
+	Tests     []*testUsage      `json:"tests"`
+	Vendors   []*vendorUsage    `json:"vendors"`
+	// NoAccessor are deviations in metadata.proto without an accessor.
+	NoAccessor []string `json:"no_accessor,omitempty"`
+	// Unreferenced are deviations whose accessor is never called.
+	Unreferenced []string `json:"unreferenced,omitempty"`
+	// NeverSet are deviations not set by any platform_exceptions.
+	NeverSet []string `json:"never_set,omitempty"`
+	// SetButUnread are deviations set for a test which nothing reads.
+	SetButUnread []*unreadSetting `json:"set_but_unread,omitempty"`
+}
+
+// analyzer holds the locations to analyze, relative to the repository root.
+type analyzer struct {
+	root          string
+	deviationsDir string
+	testDirs      []string
+	libraryDirs   []string
+}
+
+// analyze builds the report.
+func (a *analyzer) analyze() (*report, error) {
+	accessors, err := readAccessors(filepath.Join(a.root, a.deviationsDir))
+	if err != nil {
+		return nil, err
+	}
+	fieldOf := func(accessor string) (string, bool) {
+		f, ok := accessors[accessor]
+		return f, ok
+	}
+
+	libraryRefs := map[string]bool{}
+	for _, dir := range a.libraryDirs {
+		refs, err := readReferences(filepath.Join(a.root, dir))
+		if err != nil {
+			return nil, err
+		}
+		for refdir, names := range refs {
+			// The deviations package itself is not a user of the accessors.
+			if rel, _ := filepath.Rel(a.root, refdir); rel == a.deviationsDir {
+				continue
+			}
+			for name := range names {
+				if f, ok := fieldOf(name); ok {
+					libraryRefs[f] = true
+				}
+			}
+		}
+	}
+
+	tests := map[string]*testUsage{}
+	getTest := func(dir string) *testUsage {
+		rel, err := filepath.Rel(a.root, dir)
+		if err != nil {
+			rel = dir
+		}
+		if tests[rel] == nil {
+			tests[rel] = &testUsage{Path: rel}
+		}
+		return tests[rel]
+	}
+
+	referenced := map[string]bool{}
+	for f := range libraryRefs {
+		referenced[f] = true
+	}
+	for _, dir := range a.testDirs {
+		refs, err := readReferences(filepath.Join(a.root, dir))
+		if err != nil {
+			return nil, err
+		}
+		for refdir, names := range refs {
+			tu := getTest(refdir)
+			for name := range names {
+				if f, ok := fieldOf(name); ok {
+					tu.Referenced = append(tu.Referenced, f)
+					referenced[f] = true
+				}
+			}
+			sort.Strings(tu.Referenced)
+		}
+	}
+
+	set := map[string]bool{}
+	vendors := map[string]*vendorUsage{}
+	vendorDeviations := map[string]map[string]bool{}
+	for _, dir := range a.testDirs {
+		mds, err := readMetadata(filepath.Join(a.root, dir))
+		if err != nil {
+			return nil, err
+		}
+		for mddir, md := range mds {
+			tu := getTest(mddir)
+			tu.PlanID = md.GetPlanId()
+			for _, pe := range md.GetPlatformExceptions() {
+				values := deviationValues(pe.GetDeviations())
+				if len(values) == 0 {
+					continue
+				}
+				vendor := pe.GetPlatform().GetVendor().String()
+				if tu.Set == nil {
+					tu.Set = map[string]map[string]string{}
+				}
+				if tu.Set[vendor] == nil {
+					tu.Set[vendor] = map[string]string{}
+					if vendors[vendor] == nil {
+						vendors[vendor] = &vendorUsage{Vendor: vendor}
+						vendorDeviations[vendor] = map[string]bool{}
+					}
+					vendors[vendor].Tests++
+				}
+				for name, value := range values {
+					// Multiple platforms of a vendor may set the same deviation.
+					if _, ok := tu.Set[vendor][name]; ok && tu.Set[vendor][name] != value {
+						value = tu.Set[vendor][name] + "|" + value
+					}
+					tu.Set[vendor][name] = value
+					vendors[vendor].Settings++
+					vendorDeviations[vendor][name] = true
+					set[name] = true
+				}
+			}
+		}
+	}
+
+	r := &report{Accessors: map[string]string{}}
+	for accessor, f := range accessors {
+		r.Accessors[f] = accessor
+	}
+	for _, f := range goFieldToProto() {
+		if _, ok := r.Accessors[f]; !ok {
+			r.NoAccessor = append(r.NoAccessor, f)
+			continue
+		}
+		if !referenced[f] {
+			r.Unreferenced = append(r.Unreferenced, f)
+		}
+		if !set[f] {
+			r.NeverSet = append(r.NeverSet, f)
+		}
+	}
+	sort.Strings(r.NoAccessor)
+	sort.Strings(r.Unreferenced)
+	sort.Strings(r.NeverSet)
+
+	for _, tu := range tests {
+		r.Tests = append(r.Tests, tu)
+		read := map[string]bool{}
+		for _, f := range tu.Referenced {
+			read[f] = true
+		}
+		for vendor, values := range tu.Set {
+			for name := range values {
+				if !read[name] && !libraryRefs[name] {
+					r.SetButUnread = append(r.SetButUnread, &unreadSetting{Path: tu.Path, Vendor: vendor, Deviation: name})
+				}
+			}
+		}
+	}
+	sort.Slice(r.Tests, func(i, j int) bool { return r.Tests[i].Path < r.Tests[j].Path })
+	sort.Slice(r.SetButUnread, func(i, j int) bool {
+		x, y := r.SetButUnread[i], r.SetButUnread[j]
+		if x.Path != y.Path {
+			return x.Path < y.Path
+		}
+		if x.Vendor != y.Vendor {
+			return x.Vendor < y.Vendor
+		}
+		return x.Deviation < y.Deviation
+	})
+
+	for vendor, vu := range vendors {
+		vu.Deviations = len(vendorDeviations[vendor])
+		r.Vendors = append(r.Vendors, vu)
+	}
+	sort.Slice(r.Vendors, func(i, j int) bool {
+		if r.Vendors[i].Settings != r.Vendors[j].Settings {
+			return r.Vendors[i].Settings > r.Vendors[j].Settings
+		}
+		return r.Vendors[i].Vendor < r.Vendors[j].Vendor
+	})
+	return r, nil
+}
+
+// vendorNames returns the vendors that carry deviations, sorted by name.
+func (r *report) vendorNames() []string {
+	var names []string
+	for _, vu := range r.Vendors {
+		names = append(names, vu.Vendor)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validate returns one problem per metadata.textproto file whose platform_exceptions
+// are invalid, sorted by path.
+func (a *analyzer) validate() ([]string, error) {
+	var problems []string
+	for _, dir := range a.testDirs {
+		mds, err := readMetadata(filepath.Join(a.root, dir))
+		if err != nil {
+			return nil, err
+		}
+		for mddir, md := range mds {
+			if err := deviations.ValidatePlatformExceptions(md.GetPlatformExceptions()); err != nil {
+				rel, _ := filepath.Rel(a.root, mddir)
+				problems = append(problems, fmt.Sprintf("%s/metadata.textproto:\n%v", rel, err))
+			}
+		}
+	}
+	sort.Strings(problems)
+	return problems, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+const testDeviationsGo = `package deviations
+
+func lookupDUTDeviations(dut any) *mpb.Metadata_Deviations { return nil }
+
+// OmitL2MTU returns if device does not support setting the L2 MTU.
+func OmitL2MTU(dut any) bool {
+	return lookupDUTDeviations(dut).GetOmitL2Mtu()
+}
+
+// BannerDelimiter returns the banner delimiter.
+func BannerDelimiter(dut any) string {
+	return lookupDUTDeviations(dut).GetBannerDelimiter()
+}
+
+// Ipv4MissingEnabled returns if device does not support interface/ipv4/enabled.
+func IPv4MissingEnabled(dut any) bool {
+	return lookupDUTDeviations(dut).GetIpv4MissingEnabled()
+}
+`
+
+func TestAnalyze(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"internal/deviations/deviations.go": testDeviationsGo,
+		"internal/cfgplugins/cfgplugins.go": `package cfgplugins
+
+import "github.com/openconfig/featureprofiles/internal/deviations"
+
+func f(dut any) bool { return deviations.IPv4MissingEnabled(dut) }
+`,
+		"feature/foo/otg_tests/foo_test/foo_test.go": `package foo_test
+
+import dev "github.com/openconfig/featureprofiles/internal/deviations"
+
+func g(dut any) bool { return dev.OmitL2MTU(dut) }
+`,
+		"feature/foo/otg_tests/foo_test/metadata.textproto": `
+plan_id: "FOO-1.1"
+platform_exceptions: {
+  platform: { vendor: ARISTA }
+  deviations: { omit_l2_mtu: true banner_delimiter: "!" }
+}
+platform_exceptions: {
+  platform: { vendor: CISCO }
+  deviations: { ipv4_missing_enabled: true }
+}
+`,
+	})
+
+	a := &analyzer{
+		root:          root,
+		deviationsDir: filepath.Join("internal", "deviations"),
+		testDirs:      []string{"feature"},
+		libraryDirs:   []string{"internal"},
+	}
+	r, err := a.analyze()
+	if err != nil {
+		t.Fatalf("analyze() got error: %v", err)
+	}
+
+	wantTests := []*testUsage{{
+		Path:       "feature/foo/otg_tests/foo_test",
+		PlanID:     "FOO-1.1",
+		Referenced: []string{"omit_l2_mtu"},
+		Set: map[string]map[string]string{
+			"ARISTA": {"omit_l2_mtu": "true", "banner_delimiter": "!"},
+			"CISCO":  {"ipv4_missing_enabled": "true"},
+		},
+	}}
+	if diff := cmp.Diff(wantTests, r.Tests); diff != "" {
+		t.Errorf("Tests -want,+got:\n%s", diff)
+	}
+
+	wantUnread := []*unreadSetting{{
+		Path:      "feature/foo/otg_tests/foo_test",
+		Vendor:    "ARISTA",
+		Deviation: "banner_delimiter",
+	}}
+	if diff := cmp.Diff(wantUnread, r.SetButUnread); diff != "" {
+		t.Errorf("SetButUnread -want,+got:\n%s", diff)
+	}
+
+	if contains(r.Unreferenced, "omit_l2_mtu") || contains(r.Unreferenced, "ipv4_missing_enabled") {
+		t.Errorf("Unreferenced got %v, want referenced deviations excluded", r.Unreferenced)
+	}
+	if !contains(r.Unreferenced, "banner_delimiter") {
+		t.Errorf("Unreferenced got %v, want banner_delimiter", r.Unreferenced)
+	}
+	if contains(r.NeverSet, "banner_delimiter") {
+		t.Errorf("NeverSet got %v, want banner_delimiter excluded", r.NeverSet)
+	}
+	if contains(r.NoAccessor, "omit_l2_mtu") || !contains(r.NoAccessor, "traceroute_fragmentation") {
+		t.Errorf("NoAccessor got unexpected result: %v", r.NoAccessor)
+	}
+
+	wantVendors := []*vendorUsage{
+		{Vendor: "ARISTA", Settings: 2, Tests: 1, Deviations: 2},
+		{Vendor: "CISCO", Settings: 1, Tests: 1, Deviations: 1},
+	}
+	if diff := cmp.Diff(wantVendors, r.Vendors); diff != "" {
+		t.Errorf("Vendors -want,+got:\n%s", diff)
+	}
+
+	var buf bytes.Buffer
+	if err := writeCSV(&buf, r); err != nil {
+		t.Fatalf("writeCSV() got error: %v", err)
+	}
+	wantCSV := `Test Path,ID,Deviation,Accessor,Referenced,ARISTA,CISCO
+feature/foo/otg_tests/foo_test,FOO-1.1,banner_delimiter,BannerDelimiter,false,!,
+feature/foo/otg_tests/foo_test,FOO-1.1,ipv4_missing_enabled,IPv4MissingEnabled,false,,true
+feature/foo/otg_tests/foo_test,FOO-1.1,omit_l2_mtu,OmitL2MTU,true,true,
+`
+	if diff := cmp.Diff(wantCSV, buf.String()); diff != "" {
+		t.Errorf("writeCSV() -want,+got:\n%s", diff)
+	}
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// writeJSON writes the whole report as indented JSON.
+func writeJSON(w io.Writer, r *report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// writeCSV writes the per-test matrix with the columns "Test Path", "ID", "Deviation",
+// "Accessor", "Referenced", followed by one column per vendor holding the value set in
+// metadata.textproto.
+func writeCSV(w io.Writer, r *report) error {
+	vendors := r.vendorNames()
+
+	cw := csv.NewWriter(w)
+	heading := append([]string{"Test Path", "ID", "Deviation", "Accessor", "Referenced"}, vendors...)
+	if err := cw.Write(heading); err != nil {
+		return err
+	}
+
+	for _, tu := range r.Tests {
+		read := map[string]bool{}
+		for _, f := range tu.Referenced {
+			read[f] = true
+		}
+		for _, name := range testDeviations(tu) {
+			row := []string{tu.Path, tu.PlanID, name, r.Accessors[name], strconv.FormatBool(read[name])}
+			for _, vendor := range vendors {
+				row = append(row, tu.Set[vendor][name])
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeText writes a human readable summary of the report.
+func writeText(w io.Writer, r *report) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "Deviations by vendor:")
+	fmt.Fprintln(tw, "  VENDOR\tSETTINGS\tTESTS\tDEVIATIONS")
+	for _, vu := range r.Vendors {
+		fmt.Fprintf(tw, "  %s\t%d\t%d\t%d\n", vu.Vendor, vu.Settings, vu.Tests, vu.Deviations)
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "Deviations by test:")
+	for _, tu := range r.Tests {
+		names := testDeviations(tu)
+		if len(names) == 0 {
+			continue
+		}
+		fmt.Fprintf(tw, "  %s %s\n", tu.Path, tu.PlanID)
+		read := map[string]bool{}
+		for _, f := range tu.Referenced {
+			read[f] = true
+		}
+		for _, name := range names {
+			var setBy []string
+			for vendor, values := range tu.Set {
+				if _, ok := values[name]; ok {
+					setBy = append(setBy, vendor)
+				}
+			}
+			sort.Strings(setBy)
+			readMark := "-"
+			if read[name] {
+				readMark = "R"
+			}
+			fmt.Fprintf(tw, "    %s\t%s\t%s\n", readMark, name, strings.Join(setBy, ","))
+		}
+	}
+
+	section := func(title string, names []string) {
+		fmt.Fprintln(tw)
+		fmt.Fprintf(tw, "%s (%d):\n", title, len(names))
+		for _, name := range names {
+			fmt.Fprintf(tw, "  %s\t%s\n", name, r.Accessors[name])
+		}
+	}
+	section("Deviations without an accessor", r.NoAccessor)
+	section("Deviations never referenced", r.Unreferenced)
+	section("Deviations never set", r.NeverSet)
+
+	fmt.Fprintln(tw)
+	fmt.Fprintf(tw, "Deviations set but not read (%d):\n", len(r.SetButUnread))
+	for _, u := range r.SetButUnread {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\n", u.Path, u.Vendor, u.Deviation)
+	}
+
+	return tw.Flush()
+}
+
+// testDeviations returns the sorted union of deviations referenced or set by a test.
+func testDeviations(tu *testUsage) []string {
+	seen := map[string]bool{}
+	for _, f := range tu.Referenced {
+		seen[f] = true
+	}
+	for _, values := range tu.Set {
+		for name := range values {
+			seen[name] = true
+		}
+	}
+	var names []string
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command deviationreport statically analyzes how deviations are used by the tests.
+//
+// It finds every reference to the accessors in internal/deviations from Go code under
+// feature/, joins them with the platform_exceptions of every metadata.textproto, and
+// reports a per-test and per-vendor matrix along with the deviations that are dead:
+// never referenced, never set, or set for a test that never reads them.
+//
+// With -validate, it instead checks that the platform_exceptions of every
+// metadata.textproto have valid regular expressions and that entries which may match
+// the same device do not set conflicting deviations, and exits with an error otherwise.
+//
+// Usage:
+//
+//	go run ./tools/deviationreport -format=csv > deviations.csv
+//	go run ./tools/deviationreport -validate
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/openconfig/featureprofiles/tools/internal/fpciutil"
+)
+
+var (
+	root      = flag.String("root", "", "Root of the featureprofiles repository; if not specified, uses the parent of the ancestor 'feature' directory.")
+	format    = flag.String("format", "text", "Output format, one of: text, csv, json")
+	libraries = flag.String("library_dirs", "internal", "Comma separated directories of helper packages whose deviation references count as used.")
+	validate  = flag.Bool("validate", false, "Validate the platform_exceptions in all metadata.textproto files instead of reporting usage.")
+)
+
+func main() {
+	flag.Parse()
+
+	rootdir := *root
+	if rootdir == "" {
+		featuredir, err := fpciutil.FeatureDir()
+		if err != nil {
+			log.Exitf("Unable to locate feature root: %v", err)
+		}
+		rootdir = filepath.Dir(featuredir)
+	}
+
+	a := &analyzer{
+		root:          rootdir,
+		deviationsDir: filepath.Join("internal", "deviations"),
+		testDirs:      []string{"feature"},
+	}
+	if *libraries != "" {
+		a.libraryDirs = strings.Split(*libraries, ",")
+	}
+
+	if *validate {
+		problems, err := a.validate()
+		if err != nil {
+			log.Exitf("Unable to validate platform_exceptions: %v", err)
+		}
+		for _, p := range problems {
+			fmt.Fprintln(os.Stderr, p)
+		}
+		if len(problems) > 0 {
+			log.Exitf("Found invalid platform_exceptions in %d metadata.textproto files.", len(problems))
+		}
+		return
+	}
+
+	r, err := a.analyze()
+	if err != nil {
+		log.Exitf("Unable to analyze deviations: %v", err)
+	}
+
+	switch *format {
+	case "text":
+		err = writeText(os.Stdout, r)
+	case "csv":
+		err = writeCSV(os.Stdout, r)
+	case "json":
+		err = writeJSON(os.Stdout, r)
+	default:
+		log.Exitf("Unknown output format: %s", *format)
+	}
+	if err != nil {
+		log.Exitf("Error writing report: %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/openconfig/featureprofiles/topologies/binding"
+	"github.com/spf13/cobra"
+)
+
+// bindingCmd represents the lint binding command
+var bindingCmd = &cobra.Command{
+	Use:   "binding",
+	Short: "binding checks offline that a static binding satisfies a testbed",
+	Long: `binding checks offline that a static binding satisfies a testbed.
+
+It runs the same reservation logic as the static binding without dialing any
+device, and reports unmapped devices and ports, speed and PMD mismatches and
+services without a dial target as errors, and binding devices and ports that
+the testbed does not use as warnings.  It exits with a non-zero status if there
+are errors.
+
+Example:
+$ fpcli lint binding -t topologies/atedut_2.testbed -b topologies/atedut_2.binding
+
+ERROR: missing binding for port "port2" on "dut"
+WARNING: port "port3" (Ethernet3) on "dut" is not used by the testbed`,
+	Run: func(cmd *cobra.Command, args []string) {
+		testbedFile, _ := cmd.Flags().GetString("testbed")
+		bindingFile, _ := cmd.Flags().GetString("binding")
+		errs, warnings := binding.LintFiles(context.Background(), testbedFile, bindingFile)
+		for _, err := range errs {
+			fmt.Printf("ERROR: %v\n", err)
+		}
+		for _, warning := range warnings {
+			fmt.Printf("WARNING: %v\n", warning)
+		}
+		if len(errs) > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	lintCmd.AddCommand(bindingCmd)
+
+	bindingCmd.Flags().StringP("testbed", "t", "", "Testbed file, e.g. topologies/atedut_2.testbed.")
+	bindingCmd.MarkFlagRequired("testbed")
+	bindingCmd.Flags().StringP("binding", "b", "", "Static binding file, e.g. topologies/atedut_2.binding.")
+	bindingCmd.MarkFlagRequired("binding")
+}
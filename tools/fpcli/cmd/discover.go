@@ -0,0 +1,131 @@
+// Copyright © 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openconfig/featureprofiles/topologies/binding"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// discoverCmd represents the discover command
+var discoverCmd = &cobra.Command{
+	Use:   "discover",
+	Short: "discover is used to generate featureprofiles files from live devices",
+	Long: `discover is used to generate featureprofiles files from live devices.
+
+For example, you can use it to write the binding of a testbed from the LLDP
+neighbors of the devices of a rack:
+
+Example:
+$ fpcli discover binding -s rack.binding -t topologies/atedut_2.testbed > atedut_2.binding`,
+}
+
+// discoverBindingCmd represents the discover binding command
+var discoverBindingCmd = &cobra.Command{
+	Use:   "binding",
+	Short: "binding discovers the cabling of devices with LLDP and writes their binding",
+	Long: `binding discovers the cabling of devices with LLDP and writes their binding.
+
+It reads a seed binding with the names and dial options of the devices of a
+rack, and queries the LLDP neighbors and port speeds of each device over gNMI
+to find which port is cabled to which.  ATEs that cannot be queried are found
+as the neighbors of the DUTs.  The binding is written to stdout, and problems
+such as devices that cannot be queried are written to stderr as warnings.
+
+With a testbed, it writes the static binding that assigns the devices and ports
+of the rack to the testbed.  Without a testbed, or with --dynamic, it writes a
+dynamic binding with every port and link found, to be solved when reserving;
+a testbed given with --dynamic is only checked to be satisfied by the binding.
+
+Example:
+$ fpcli discover binding -s rack.binding -t topologies/atedut_2.testbed
+
+duts {
+  id: "dut"
+  name: "dut1.lab"
+  ports {
+    id: "port1"
+    name: "Ethernet1/1"
+    speed: S_100GB
+  }
+  ...`,
+	Run: func(cmd *cobra.Command, args []string) {
+		seedFile, _ := cmd.Flags().GetString("seed")
+		testbedFile, _ := cmd.Flags().GetString("testbed")
+		dynamic, _ := cmd.Flags().GetBool("dynamic")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		b, warnings, err := binding.DiscoverFile(ctx, seedFile)
+		for _, warning := range warnings {
+			fmt.Fprintf(os.Stderr, "WARNING: %v\n", warning)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR: %v\n", err)
+			os.Exit(1)
+		}
+		if testbedFile != "" {
+			// Solve even for a dynamic binding, to check that it satisfies the testbed.
+			static, err := solve(ctx, testbedFile, b)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "ERROR: %v\n", err)
+				os.Exit(1)
+			}
+			if !dynamic {
+				b = static
+			}
+		}
+		out, err := prototext.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(b)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR: %v\n", err)
+			os.Exit(1)
+		}
+		os.Stdout.Write(out)
+	},
+}
+
+// solve reads the testbed file and solves the dynamic binding for it.
+func solve(ctx context.Context, testbedFile string, dyn *bindpb.Binding) (*bindpb.Binding, error) {
+	in, err := os.ReadFile(testbedFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read testbed file: %w", err)
+	}
+	tb := &opb.Testbed{}
+	if err := prototext.Unmarshal(in, tb); err != nil {
+		return nil, fmt.Errorf("unable to parse testbed file: %w", err)
+	}
+	return binding.Solve(ctx, tb, dyn)
+}
+
+func init() {
+	rootCmd.AddCommand(discoverCmd)
+	discoverCmd.AddCommand(discoverBindingCmd)
+
+	discoverBindingCmd.Flags().StringP("seed", "s", "", "Binding file with the names and dial options of the devices.")
+	discoverBindingCmd.MarkFlagRequired("seed")
+	discoverBindingCmd.Flags().StringP("testbed", "t", "", "Testbed file to write the static binding of, e.g. topologies/atedut_2.testbed.")
+	discoverBindingCmd.Flags().Bool("dynamic", false, "Write the dynamic binding even if a testbed is given.")
+	discoverBindingCmd.Flags().Duration("timeout", time.Minute, "Timeout to query the devices.")
+}
@@ -0,0 +1,38 @@
+// Copyright © 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "lint is used to check featureprofiles files offline",
+	Long: `lint is used to check featureprofiles files offline, e.g. in pre-submit.
+
+For example, you can use it to check that a binding satisfies a testbed:
+
+Example:
+$ fpcli lint binding -t topologies/atedut_2.testbed -b topologies/atedut_2.binding
+
+ERROR: missing binding for port "port2" on "dut"
+WARNING: port "port3" (Ethernet3) on "dut" is not used by the testbed`,
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+}
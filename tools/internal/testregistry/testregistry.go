@@ -0,0 +1,102 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testregistry reads, sorts and writes the testregistry.textproto file.
+package testregistry
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	tpb "github.com/openconfig/featureprofiles/proto/testregistry_go_proto"
+)
+
+// READMEURLPrefix is the prefix of the readme URLs of the tests in the registry,
+// followed by the path of the test directory relative to the repository root.
+const READMEURLPrefix = "https://github.com/openconfig/featureprofiles/blob/main/"
+
+const header = "# proto-file: /proto/testregistry.proto\n# proto-message: TestRegistry\n\n"
+
+var marshaller = prototext.MarshalOptions{
+	Multiline: true,
+	Indent:    "  ",
+}
+
+// spacesRE matches the spaces after a field name, which prototext randomly doubles so
+// that its output is not relied upon to be stable.
+var spacesRE = regexp.MustCompile(`(?m)^(\s*\w+:) +`)
+
+// Read reads the test registry from a file.
+func Read(file string) (*tpb.TestRegistry, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	r := &tpb.TestRegistry{}
+	if err := prototext.Unmarshal(b, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Sort returns a registry with the tests sorted lexically by ID, keeping the order of
+// the tests with the same ID, and with identical entries removed.
+func Sort(r *tpb.TestRegistry) *tpb.TestRegistry {
+	ids := []string{}
+	tests := map[string][]*tpb.Test{}
+	for _, t := range r.GetTest() {
+		if _, ok := tests[t.GetId()]; !ok {
+			ids = append(ids, t.GetId())
+		}
+
+		// Deduplicate identical entries.
+		var skip bool
+		for _, existing := range tests[t.GetId()] {
+			if proto.Equal(existing, t) {
+				skip = true
+			}
+		}
+		if skip {
+			continue
+		}
+		tests[t.GetId()] = append(tests[t.GetId()], t)
+	}
+
+	n := &tpb.TestRegistry{
+		Name: r.GetName(),
+		Test: []*tpb.Test{},
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		n.Test = append(n.Test, tests[id]...)
+	}
+	return n
+}
+
+// Write writes the test registry to a file with the textproto header.
+func Write(file string, r *tpb.TestRegistry) error {
+	s, err := marshaller.Marshal(r)
+	if err != nil {
+		return err
+	}
+	b := &bytes.Buffer{}
+	b.WriteString(header)
+	b.Write(spacesRE.ReplaceAll(s, []byte("$1 ")))
+	return os.WriteFile(file, b.Bytes(), 0644)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testregistry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	tpb "github.com/openconfig/featureprofiles/proto/testregistry_go_proto"
+)
+
+func TestSortWriteRead(t *testing.T) {
+	r := &tpb.TestRegistry{
+		Name: "Test Registry",
+		Test: []*tpb.Test{
+			{Id: "XX-2.1", Description: "Qux"},
+			{Id: "XX-1.1", Description: "Foo"},
+			{Id: "XX-2.1", Description: "Qux"},
+			{Id: "XX-1.1", Readme: []string{"README.md"}},
+		},
+	}
+	want := &tpb.TestRegistry{
+		Name: "Test Registry",
+		Test: []*tpb.Test{
+			{Id: "XX-1.1", Description: "Foo"},
+			{Id: "XX-1.1", Readme: []string{"README.md"}},
+			{Id: "XX-2.1", Description: "Qux"},
+		},
+	}
+	got := Sort(r)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Sort -want,+got:\n%s", diff)
+	}
+
+	file := filepath.Join(t.TempDir(), "testregistry.textproto")
+	if err := Write(file, got); err != nil {
+		t.Fatalf("Write got err: %v", err)
+	}
+	const wantText = `# proto-file: /proto/testregistry.proto
+# proto-message: TestRegistry
+
+name: "Test Registry"
+test: {
+  id: "XX-1.1"
+  description: "Foo"
+}
+test: {
+  id: "XX-1.1"
+  readme: "README.md"
+}
+test: {
+  id: "XX-2.1"
+  description: "Qux"
+}
+`
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantText, string(b)); diff != "" {
+		t.Errorf("Write -want,+got:\n%s", diff)
+	}
+
+	read, err := Read(file)
+	if err != nil {
+		t.Fatalf("Read got err: %v", err)
+	}
+	if diff := cmp.Diff(want, read, protocmp.Transform()); diff != "" {
+		t.Errorf("Read -want,+got:\n%s", diff)
+	}
+}
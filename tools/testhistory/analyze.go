@@ -0,0 +1,171 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sort"
+	"time"
+)
+
+// report is the history of the runs in the store.
+type report struct {
+	Tests []*testHistory `json:"tests"`
+	Flaky []*flaky       `json:"flaky"`
+}
+
+// testHistory is the history of a test on a DUT platform.
+type testHistory struct {
+	Test     string   `json:"test"`
+	PlanID   string   `json:"plan_id,omitempty"`
+	Platform string   `json:"platform"`
+	Runs     int      `json:"runs"`   // Runs that passed or failed.
+	Passed   int      `json:"passed"` // Runs that passed.
+	PassRate float64  `json:"pass_rate"`
+	History  string   `json:"history"` // Outcomes of the last runs, oldest first, e.g. "PPF".
+	Bisect   *bisect  `json:"bisect,omitempty"`
+	Latest   *runInfo `json:"latest,omitempty"`
+}
+
+// runInfo identifies a run in the report.
+type runInfo struct {
+	Time      time.Time `json:"time"`
+	OSVersion string    `json:"os_version,omitempty"`
+	Commit    string    `json:"commit,omitempty"`
+}
+
+// bisect hints at the change that made a test fail: the last run that passed before
+// the failures that the test still has, and the first of those failures.
+type bisect struct {
+	LastPass  *runInfo `json:"last_pass,omitempty"`
+	FirstFail *runInfo `json:"first_fail"`
+	Failures  int      `json:"failures"` // Number of consecutive failures.
+}
+
+// flaky is a test that both passed and failed on the same platform, OS version and
+// commit, so that its failures are not explained by a change.
+type flaky struct {
+	Test      string `json:"test"`
+	PlanID    string `json:"plan_id,omitempty"`
+	Platform  string `json:"platform"`
+	OSVersion string `json:"os_version"`
+	Commit    string `json:"commit"`
+	Runs      int    `json:"runs"`
+	Passed    int    `json:"passed"`
+	Flips     int    `json:"flips"` // Changes between passing and failing.
+}
+
+// analyze builds the report of the runs, keeping the last historyLen outcomes of each
+// test in its history.
+func analyze(runs []*run, historyLen int) *report {
+	type key struct{ test, platform string }
+	byTest := make(map[key][]*run)
+	for _, r := range runs {
+		if r.Outcome == outcomeSkip {
+			continue
+		}
+		k := key{r.Test, r.platform()}
+		byTest[k] = append(byTest[k], r)
+	}
+	keys := make([]key, 0, len(byTest))
+	for k := range byTest {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].test != keys[j].test {
+			return keys[i].test < keys[j].test
+		}
+		return keys[i].platform < keys[j].platform
+	})
+
+	rep := &report{}
+	for _, k := range keys {
+		rs := byTest[k]
+		sort.SliceStable(rs, func(i, j int) bool { return rs[i].Time.Before(rs[j].Time) })
+		h := &testHistory{
+			Test:     k.test,
+			PlanID:   rs[len(rs)-1].PlanID,
+			Platform: k.platform,
+			Runs:     len(rs),
+			Bisect:   findBisect(rs),
+			Latest:   info(rs[len(rs)-1]),
+		}
+		for i, r := range rs {
+			if r.Outcome == outcomePass {
+				h.Passed++
+			}
+			if i >= len(rs)-historyLen {
+				h.History += r.Outcome[:1]
+			}
+		}
+		h.PassRate = float64(h.Passed) / float64(h.Runs)
+		rep.Tests = append(rep.Tests, h)
+		rep.Flaky = append(rep.Flaky, findFlaky(rs)...)
+	}
+	return rep
+}
+
+func info(r *run) *runInfo {
+	return &runInfo{Time: r.Time, OSVersion: r.OSVersion, Commit: r.Commit}
+}
+
+// findBisect returns the bisect hint of the runs of a test, in time order, or nil if
+// the latest run passed.
+func findBisect(rs []*run) *bisect {
+	i := len(rs)
+	for i > 0 && rs[i-1].Outcome == outcomeFail {
+		i--
+	}
+	if i == len(rs) {
+		return nil
+	}
+	b := &bisect{FirstFail: info(rs[i]), Failures: len(rs) - i}
+	if i > 0 {
+		b.LastPass = info(rs[i-1])
+	}
+	return b
+}
+
+// findFlaky returns the flaky groups of the runs of a test, in time order.
+func findFlaky(rs []*run) []*flaky {
+	type key struct{ osVersion, commit string }
+	var keys []key
+	groups := make(map[key]*flaky)
+	last := make(map[key]string)
+	for _, r := range rs {
+		k := key{r.OSVersion, r.Commit}
+		f, ok := groups[k]
+		if !ok {
+			f = &flaky{Test: r.Test, PlanID: r.PlanID, Platform: r.platform(), OSVersion: r.OSVersion, Commit: r.Commit}
+			groups[k] = f
+			keys = append(keys, k)
+		}
+		f.Runs++
+		if r.Outcome == outcomePass {
+			f.Passed++
+		}
+		if prev, ok := last[k]; ok && prev != r.Outcome {
+			f.Flips++
+		}
+		last[k] = r.Outcome
+	}
+	var flakes []*flaky
+	for _, k := range keys {
+		// Without both the commit and the OS version, a change may explain the flips.
+		if f := groups[k]; f.Flips > 0 && k.commit != "" && k.osVersion != "" {
+			flakes = append(flakes, f)
+		}
+	}
+	return flakes
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAnalyze(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	newRun := func(test string, d int, osVer, commit, outcome string) *run {
+		return &run{Test: test, Vendor: "ARISTA", Model: "DCS-7280", OSVersion: osVer, Commit: commit, Time: day(d), Outcome: outcome}
+	}
+	runs := []*run{
+		// Flaky on 4.30 at commit b, out of time order.
+		newRun("flaky", 3, "4.30", "b", outcomePass),
+		newRun("flaky", 1, "4.30", "b", outcomePass),
+		newRun("flaky", 2, "4.30", "b", outcomeFail),
+		// Broken by the upgrade to 4.31.
+		newRun("broken", 1, "4.30", "a", outcomePass),
+		newRun("broken", 2, "4.30", "b", outcomePass),
+		newRun("broken", 3, "4.31", "b", outcomeFail),
+		newRun("broken", 4, "4.31", "c", outcomeFail),
+		newRun("broken", 5, "4.31", "c", outcomeSkip),
+	}
+	got := analyze(runs, 3)
+
+	want := &report{
+		Tests: []*testHistory{{
+			Test:     "broken",
+			Platform: "ARISTA DCS-7280",
+			Runs:     4,
+			Passed:   2,
+			PassRate: 0.5,
+			History:  "PFF",
+			Bisect: &bisect{
+				LastPass:  &runInfo{Time: day(2), OSVersion: "4.30", Commit: "b"},
+				FirstFail: &runInfo{Time: day(3), OSVersion: "4.31", Commit: "b"},
+				Failures:  2,
+			},
+			Latest: &runInfo{Time: day(4), OSVersion: "4.31", Commit: "c"},
+		}, {
+			Test:     "flaky",
+			Platform: "ARISTA DCS-7280",
+			Runs:     3,
+			Passed:   2,
+			PassRate: 2.0 / 3,
+			History:  "PFP",
+			Latest:   &runInfo{Time: day(3), OSVersion: "4.30", Commit: "b"},
+		}},
+		Flaky: []*flaky{{
+			Test:      "flaky",
+			Platform:  "ARISTA DCS-7280",
+			OSVersion: "4.30",
+			Commit:    "b",
+			Runs:      3,
+			Passed:    2,
+			Flips:     2,
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("analyze() got unexpected report (-want +got):\n%s", diff)
+	}
+
+	var buf bytes.Buffer
+	if err := writeText(&buf, got); err != nil {
+		t.Fatalf("writeText() got err: %v", err)
+	}
+	for _, want := range []string{
+		"first failing OS version 4.31, last passing 4.30",
+		"Flaky tests (1):",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("writeText() got:\n%s\nwant it to contain %q", buf.String(), want)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// writeJSON writes the whole report as indented JSON.
+func writeJSON(w io.Writer, r *report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// writeText writes a human readable summary of the report.
+func writeText(w io.Writer, r *report) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "Pass rate by test:")
+	fmt.Fprintln(tw, "  TEST\tPLAN ID\tPLATFORM\tPASSED\tRATE\tHISTORY")
+	for _, h := range r.Tests {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%d/%d\t%.0f%%\t%s\n", h.Test, h.PlanID, h.Platform, h.Passed, h.Runs, 100*h.PassRate, h.History)
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintf(tw, "Flaky tests (%d):\n", len(r.Flaky))
+	if len(r.Flaky) > 0 {
+		fmt.Fprintln(tw, "  TEST\tPLAN ID\tPLATFORM\tOS VERSION\tCOMMIT\tPASSED\tFLIPS")
+	}
+	for _, f := range r.Flaky {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\t%d/%d\t%d\n", f.Test, f.PlanID, f.Platform, f.OSVersion, f.Commit, f.Passed, f.Runs, f.Flips)
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "Failing tests:")
+	for _, h := range r.Tests {
+		b := h.Bisect
+		if b == nil {
+			continue
+		}
+		fmt.Fprintf(tw, "  %s %s on %s: failed %d times since %s\n", h.Test, h.PlanID, h.Platform, b.Failures, b.FirstFail.Time.Format(time.RFC3339))
+		switch {
+		case b.LastPass == nil:
+			fmt.Fprintln(tw, "    never passed")
+		default:
+			if b.LastPass.OSVersion != b.FirstFail.OSVersion {
+				fmt.Fprintf(tw, "    first failing OS version %s, last passing %s\n", b.FirstFail.OSVersion, b.LastPass.OSVersion)
+			}
+			if b.LastPass.Commit != b.FirstFail.Commit {
+				fmt.Fprintf(tw, "    first failing commit %s, last passing %s\n", b.FirstFail.Commit, b.LastPass.Commit)
+			}
+			if b.LastPass.OSVersion == b.FirstFail.OSVersion && b.LastPass.Commit == b.FirstFail.Commit {
+				fmt.Fprintln(tw, "    same OS version and commit as the last pass")
+			}
+		}
+	}
+
+	return tw.Flush()
+}
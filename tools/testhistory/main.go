@@ -0,0 +1,93 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command testhistory aggregates the results of test runs over time.
+//
+// The ingest command reads the JUnit XML files written by Ondatra, and stores one run
+// per test suite in a JSON-lines file, keyed by the test UUID and identified by the
+// DUT vendor, model and OS version and by the git commit reported by rundata.  Files
+// ingested again are not stored twice.
+//
+// The report command reads the store and reports the pass rate history of each test
+// on each DUT platform, the tests that are flaky, i.e. that both passed and failed
+// with the same commit and OS version, and for the tests that are failing, the last
+// passing and first failing OS version and commit to bisect the failure.
+//
+// Usage:
+//
+//	go run ./tools/testhistory -store=runs.jsonl ingest results/*.xml
+//	go run ./tools/testhistory -store=runs.jsonl report
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"flag"
+
+	log "github.com/golang/glog"
+)
+
+var (
+	storeFile  = flag.String("store", "testhistory.jsonl", "JSON-lines file that the runs are stored in.")
+	format     = flag.String("format", "text", "Output format of the report, one of: text, json")
+	historyLen = flag.Int("history", 20, "Number of the latest outcomes of each test to show in the report.")
+)
+
+func main() {
+	flag.Parse()
+
+	s, err := openStore(*storeFile)
+	if err != nil {
+		log.Exitf("Unable to read store: %v", err)
+	}
+
+	switch flag.Arg(0) {
+	case "ingest":
+		var runs []*run
+		for _, file := range flag.Args()[1:] {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				log.Exitf("Unable to read results: %v", err)
+			}
+			rs, err := parseXML(data, file)
+			if err != nil {
+				log.Exitf("Unable to parse %s: %v", file, err)
+			}
+			runs = append(runs, rs...)
+		}
+		added, err := s.add(runs)
+		if err != nil {
+			log.Exitf("Unable to store runs: %v", err)
+		}
+		fmt.Fprintf(os.Stderr, "Stored %d new runs out of %d.\n", added, len(runs))
+
+	case "report":
+		r := analyze(s.runs, *historyLen)
+		switch *format {
+		case "text":
+			err = writeText(os.Stdout, r)
+		case "json":
+			err = writeJSON(os.Stdout, r)
+		default:
+			log.Exitf("Unknown output format: %s", *format)
+		}
+		if err != nil {
+			log.Exitf("Error writing report: %v", err)
+		}
+
+	default:
+		log.Exitf("Unknown command %q; want ingest or report", flag.Arg(0))
+	}
+}
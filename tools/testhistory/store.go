@@ -0,0 +1,265 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcomes of a run.
+const (
+	outcomePass = "PASS"
+	outcomeFail = "FAIL"
+	outcomeSkip = "SKIP"
+)
+
+// run is the result of a test suite on a testbed, as stored in one line of the store.
+type run struct {
+	ID        string    `json:"id"`   // SHA-256 of the suite, so that it is only stored once.
+	Test      string    `json:"test"` // test.uuid, or test.plan_id, or the suite name.
+	PlanID    string    `json:"plan_id,omitempty"`
+	Suite     string    `json:"suite"`
+	Vendor    string    `json:"vendor,omitempty"`
+	Model     string    `json:"model,omitempty"`
+	OSVersion string    `json:"os_version,omitempty"`
+	Commit    string    `json:"commit,omitempty"`
+	Time      time.Time `json:"time"`
+	Outcome   string    `json:"outcome"`
+	Failed    []string  `json:"failed,omitempty"` // Names of the failed test cases.
+	Source    string    `json:"source,omitempty"` // File that the run was ingested from.
+}
+
+// platform identifies the DUT platform of a run.
+func (r *run) platform() string {
+	return strings.TrimSpace(r.Vendor + " " + r.Model)
+}
+
+// xmlSuites is the JUnit XML written by Ondatra, either a testsuites element or a
+// single testsuite.
+type xmlSuites struct {
+	XMLName xml.Name   `xml:""`
+	Suites  []xmlSuite `xml:"testsuite"`
+}
+
+type xmlSuite struct {
+	Name       string        `xml:"name,attr"`
+	Failures   int           `xml:"failures,attr"`
+	Errors     int           `xml:"errors,attr"`
+	Timestamp  string        `xml:"timestamp,attr"`
+	Properties []xmlProperty `xml:"properties>property"`
+	Testcases  []xmlTestcase `xml:"testcase"`
+}
+
+type xmlProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type xmlTestcase struct {
+	Name    string    `xml:"name,attr"`
+	Skipped *struct{} `xml:"skipped"`
+	Error   *struct{} `xml:"error"`
+	Failure *struct{} `xml:"failure"`
+}
+
+// parseXML parses the runs of a JUnit XML file.
+func parseXML(data []byte, source string) ([]*run, error) {
+	var suites xmlSuites
+	if err := xml.Unmarshal(data, &suites); err != nil {
+		return nil, err
+	}
+	switch suites.XMLName.Local {
+	case "testsuites":
+	case "testsuite":
+		var suite xmlSuite
+		if err := xml.Unmarshal(data, &suite); err != nil {
+			return nil, err
+		}
+		suites.Suites = []xmlSuite{suite}
+	default:
+		return nil, fmt.Errorf("unexpected root element %q", suites.XMLName.Local)
+	}
+
+	var runs []*run
+	for _, s := range suites.Suites {
+		r, err := suiteRun(&s)
+		if err != nil {
+			return nil, fmt.Errorf("suite %q: %w", s.Name, err)
+		}
+		r.Source = source
+		runs = append(runs, r)
+	}
+	return runs, nil
+}
+
+// suiteRun converts a test suite to a run.
+func suiteRun(s *xmlSuite) (*run, error) {
+	props := make(map[string]string)
+	for _, p := range s.Properties {
+		props[p.Name] = p.Value
+	}
+	r := &run{
+		Test:   props["test.uuid"],
+		PlanID: props["test.plan_id"],
+		Suite:  s.Name,
+		Commit: props["git.commit"],
+	}
+	if r.Test == "" {
+		r.Test = r.PlanID
+	}
+	if r.Test == "" {
+		r.Test = r.Suite
+	}
+	if dut := mainDUT(props); dut != "" {
+		r.Vendor = props[dut+".vendor"]
+		r.Model = props[dut+".model"]
+		r.OSVersion = props[dut+".os_version"]
+	}
+
+	switch {
+	case props["time.begin"] != "":
+		sec, err := strconv.ParseInt(props["time.begin"], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time.begin: %w", err)
+		}
+		r.Time = time.Unix(sec, 0).UTC()
+	case s.Timestamp != "":
+		t, err := time.Parse("2006-01-02T15:04:05", strings.TrimSuffix(s.Timestamp, "Z"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp: %w", err)
+		}
+		r.Time = t
+	}
+
+	skipped := 0
+	for _, tc := range s.Testcases {
+		switch {
+		case tc.Failure != nil || tc.Error != nil:
+			r.Failed = append(r.Failed, tc.Name)
+		case tc.Skipped != nil:
+			skipped++
+		}
+	}
+	switch {
+	case len(r.Failed) > 0 || s.Failures > 0 || s.Errors > 0:
+		r.Outcome = outcomeFail
+	case skipped == len(s.Testcases):
+		r.Outcome = outcomeSkip
+	default:
+		r.Outcome = outcomePass
+	}
+
+	sum, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(sum)
+	r.ID = hex.EncodeToString(h[:])
+	return r, nil
+}
+
+// mainDUT returns the ID of the DUT whose platform identifies the run: "dut" if the
+// testbed has it, otherwise the first DUT by ID, or "" if there is no DUT info.
+func mainDUT(props map[string]string) string {
+	var ids []string
+	for k := range props {
+		if id, ok := strings.CutSuffix(k, ".os_version"); ok {
+			ids = append(ids, id)
+		}
+		if id, ok := strings.CutSuffix(k, ".vendor.full"); ok {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return ""
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if id == "dut" {
+			return id
+		}
+	}
+	return ids[0]
+}
+
+// store is a JSON-lines file of runs.
+type store struct {
+	path string
+	runs []*run
+	ids  map[string]bool
+}
+
+// openStore reads the runs of the store file, which need not exist yet.
+func openStore(path string) (*store, error) {
+	s := &store{path: path, ids: make(map[string]bool)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(nil, 16<<20)
+	for line := 1; sc.Scan(); line++ {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		r := &run{}
+		if err := json.Unmarshal(sc.Bytes(), r); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		s.runs = append(s.runs, r)
+		s.ids[r.ID] = true
+	}
+	return s, sc.Err()
+}
+
+// add appends the runs to the store file, skipping those already stored, and returns
+// the number of runs added.
+func (s *store) add(runs []*run) (int, error) {
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return 0, err
+	}
+	enc := json.NewEncoder(f)
+	added := 0
+	for _, r := range runs {
+		if s.ids[r.ID] {
+			continue
+		}
+		if err := enc.Encode(r); err != nil {
+			f.Close()
+			return added, err
+		}
+		s.runs = append(s.runs, r)
+		s.ids[r.ID] = true
+		added++
+	}
+	return added, f.Close()
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+const testXML = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="github.com/openconfig/featureprofiles/feature/foo/foo_test" tests="2" failures="1" errors="0" id="0" time="12.3">
+    <properties>
+      <property name="test.uuid" value="1234"></property>
+      <property name="test.plan_id" value="FOO-1.1"></property>
+      <property name="git.commit" value="abcd"></property>
+      <property name="time.begin" value="1700000000"></property>
+      <property name="ate.vendor" value="IXIA"></property>
+      <property name="dut.vendor" value="ARISTA"></property>
+      <property name="dut.vendor.full" value="Arista Networks"></property>
+      <property name="dut.model" value="DCS-7280"></property>
+      <property name="dut.os_version" value="4.29.0F"></property>
+    </properties>
+    <testcase name="TestFoo" classname="foo_test" time="10.0"></testcase>
+    <testcase name="TestFoo/bar" classname="foo_test" time="2.3">
+      <failure message="Failed" type="">bar failed</failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="github.com/openconfig/featureprofiles/feature/baz/baz_test" tests="1" failures="0" errors="0" id="1" time="1.0" timestamp="2023-11-14T22:13:20">
+    <properties>
+      <property name="dut1.os_version" value="7.7.1"></property>
+      <property name="dut2.os_version" value="7.8.1"></property>
+    </properties>
+    <testcase name="TestBaz" classname="baz_test" time="1.0"></testcase>
+  </testsuite>
+</testsuites>
+`
+
+func TestParseXML(t *testing.T) {
+	got, err := parseXML([]byte(testXML), "results.xml")
+	if err != nil {
+		t.Fatalf("parseXML() got err: %v", err)
+	}
+	want := []*run{{
+		Test:      "1234",
+		PlanID:    "FOO-1.1",
+		Suite:     "github.com/openconfig/featureprofiles/feature/foo/foo_test",
+		Vendor:    "ARISTA",
+		Model:     "DCS-7280",
+		OSVersion: "4.29.0F",
+		Commit:    "abcd",
+		Time:      time.Unix(1700000000, 0).UTC(),
+		Outcome:   outcomeFail,
+		Failed:    []string{"TestFoo/bar"},
+		Source:    "results.xml",
+	}, {
+		Test:      "github.com/openconfig/featureprofiles/feature/baz/baz_test",
+		Suite:     "github.com/openconfig/featureprofiles/feature/baz/baz_test",
+		OSVersion: "7.7.1",
+		Time:      time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC),
+		Outcome:   outcomePass,
+		Source:    "results.xml",
+	}}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(run{}, "ID")); diff != "" {
+		t.Errorf("parseXML() got unexpected runs (-want +got):\n%s", diff)
+	}
+	if got[0].ID == "" || got[0].ID == got[1].ID {
+		t.Errorf("parseXML() got IDs %q and %q, want distinct IDs", got[0].ID, got[1].ID)
+	}
+}
+
+func TestParseXMLSingleSuite(t *testing.T) {
+	const xml = `<testsuite name="foo_test"><testcase name="TestFoo"><skipped/></testcase></testsuite>`
+	got, err := parseXML([]byte(xml), "")
+	if err != nil {
+		t.Fatalf("parseXML() got err: %v", err)
+	}
+	if len(got) != 1 || got[0].Test != "foo_test" || got[0].Outcome != outcomeSkip {
+		t.Errorf("parseXML() got %+v, want a skipped run of foo_test", got)
+	}
+}
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "runs.jsonl")
+	runs, err := parseXML([]byte(testXML), "results.xml")
+	if err != nil {
+		t.Fatalf("parseXML() got err: %v", err)
+	}
+
+	s, err := openStore(path)
+	if err != nil {
+		t.Fatalf("openStore() got err: %v", err)
+	}
+	if added, err := s.add(runs); err != nil || added != 2 {
+		t.Fatalf("add() got %d, %v, want 2 runs added", added, err)
+	}
+
+	s, err = openStore(path)
+	if err != nil {
+		t.Fatalf("openStore() got err: %v", err)
+	}
+	if diff := cmp.Diff(runs, s.runs); diff != "" {
+		t.Errorf("openStore() got unexpected runs (-want +got):\n%s", diff)
+	}
+	if added, err := s.add(runs); err != nil || added != 0 {
+		t.Errorf("add() of the same runs got %d, %v, want none added", added, err)
+	}
+}
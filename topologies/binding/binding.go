@@ -23,8 +23,10 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sort"
 	"time"
 
+	"github.com/golang/glog"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/open-traffic-generator/snappi/gosnappi"
 	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
@@ -32,6 +34,7 @@ import (
 	"github.com/openconfig/gnoigo"
 	gnpsipb "github.com/openconfig/gnpsi/proto/gnpsi"
 	grpb "github.com/openconfig/gribi/v1/proto/service"
+	"github.com/openconfig/ondatra"
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/binding/grpcutil"
 	"github.com/openconfig/ondatra/binding/introspect"
@@ -64,6 +67,8 @@ type staticBind struct {
 	r          resolver
 	resv       *binding.Reservation
 	pushConfig bool
+	// resetWorkers is the maximum number of DUTs reset concurrently.
+	resetWorkers int
 }
 
 var _ binding.Binding = (*staticBind)(nil)
@@ -134,6 +139,7 @@ func (b *staticBind) Release(ctx context.Context) error {
 	if err := b.releaseIxSessions(ctx); err != nil {
 		return err
 	}
+	closeJumpClients()
 	b.resv = nil
 	return nil
 }
@@ -143,15 +149,32 @@ func (b *staticBind) FetchReservation(_ context.Context, id string) (*binding.Re
 	return nil, errors.New("static binding does not support fetching an existing reservation")
 }
 
+// reset resets the DUTs concurrently, and reports how long each reset took and
+// whether it failed.
 func (b *staticBind) reset(ctx context.Context) error {
+	var duts []*staticDUT
 	for _, dut := range b.resv.DUTs {
 		if sdut, ok := dut.(*staticDUT); ok {
-			if err := sdut.reset(ctx); err != nil {
-				return fmt.Errorf("could not reset device %s: %w", sdut.Name(), err)
-			}
+			duts = append(duts, sdut)
 		}
 	}
-	return nil
+	sort.Slice(duts, func(i, j int) bool { return duts[i].Name() < duts[j].Name() })
+
+	results := resetDUTs(ctx, duts, b.resetWorkers, func(ctx context.Context, d *staticDUT) error {
+		return d.reset(ctx)
+	})
+	var errs []error
+	for _, res := range results {
+		ondatra.Report().AddSuiteProperty("reset."+res.dut+".seconds", fmt.Sprintf("%.1f", res.duration.Seconds()))
+		if res.err != nil {
+			glog.Errorf("Reset of device %s failed after %v: %v", res.dut, res.duration, res.err)
+			ondatra.Report().AddSuiteProperty("reset."+res.dut+".error", res.err.Error())
+			errs = append(errs, fmt.Errorf("could not reset device %s: %w", res.dut, res.err))
+			continue
+		}
+		glog.Infof("Reset device %s in %v", res.dut, res.duration)
+	}
+	return errors.Join(errs...)
 }
 
 func (d *staticDUT) Dialer(svc introspect.Service) (*introspect.Dialer, error) {
@@ -172,7 +195,10 @@ func (d *staticDUT) reset(ctx context.Context) error {
 	if err := resetGNMI(ctx, d); err != nil {
 		return err
 	}
-	return resetGRIBI(ctx, d)
+	if err := resetGRIBI(ctx, d); err != nil {
+		return err
+	}
+	return verifyReset(ctx, d)
 }
 
 func (d *staticDUT) PushConfig(ctx context.Context, config string, reset bool) error {
@@ -354,6 +380,9 @@ func createSSHClient(config *ssh.ClientConfig, sshOpts *bindpb.Options, callback
 		}
 		config.HostKeyCallback = combineHostKeyCallbacks(append(callbacks, cb)...)
 	}
+	if sshOpts.GetJumpHost() != "" {
+		return jumpSSH(config, sshOpts)
+	}
 	return ssh.Dial("tcp", sshOpts.Target, config)
 }
 
@@ -642,6 +671,11 @@ func dialOpts(bopts *bindpb.Options) ([]grpc.DialOption, error) {
 	if bopts.MaxRecvMsgSize != 0 {
 		opts = append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(int(bopts.MaxRecvMsgSize))))
 	}
+	if bopts.GetJumpHost() != "" {
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return jumpDial(ctx, bopts, addr)
+		}))
+	}
 	if bopts.Timeout != 0 {
 		timeout := time.Duration(bopts.Timeout) * time.Second
 		retryOpt := grpc_retry.WithPerRetryTimeout(timeout)
@@ -652,9 +686,24 @@ func dialOpts(bopts *bindpb.Options) ([]grpc.DialOption, error) {
 			grpcutil.WithStreamDefaultTimeout(timeout),
 		)
 	}
+	for _, newInterceptor := range streamInterceptors {
+		opts = append(opts, grpc.WithChainStreamInterceptor(newInterceptor(bopts.Target)))
+	}
+	// Chained last, so that every retry of a call is recorded.
+	opts = append(opts, recorderDialOpts(bopts.Target)...)
 	return opts, nil
 }
 
+// streamInterceptors are the stream interceptors added by AddStreamInterceptor.
+var streamInterceptors []func(target string) grpc.StreamClientInterceptor
+
+// AddStreamInterceptor adds a stream interceptor to the gRPC connections of the static
+// binding, calling newInterceptor with the target of each connection.  It must be called
+// before the testbed is reserved.
+func AddStreamInterceptor(newInterceptor func(target string) grpc.StreamClientInterceptor) {
+	streamInterceptors = append(streamInterceptors, newInterceptor)
+}
+
 func makeDialer(params *svcParams, bopts *bindpb.Options) (*introspect.Dialer, error) {
 	opts, err := dialOpts(bopts)
 	if err != nil {
@@ -668,6 +717,10 @@ func makeDialer(params *svcParams, bopts *bindpb.Options) (*introspect.Dialer, e
 				_, cancelFunc = context.WithTimeout(ctx, time.Duration(bopts.Timeout)*time.Second)
 				defer cancelFunc()
 			}
+			if bopts.GetJumpHost() != "" {
+				// Leave the target to be resolved by the jump host.
+				target = "passthrough:///" + target
+			}
 			return grpcDialContextFn(target, opts...)
 		},
 		DialTarget: bopts.Target,
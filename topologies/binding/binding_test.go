@@ -280,6 +280,7 @@ func TestDialer(t *testing.T) {
 		port:   wantDevPort,
 		optsFn: func(d *bindpb.Device) *bindpb.Options { return nil },
 	}
+	t.Cleanup(func() { delete(dutSvcParams, fakeSvc) })
 	d := &staticDUT{
 		r:   resolver{&bindpb.Binding{}},
 		dev: &bindpb.Device{Name: wantDevName},
@@ -0,0 +1,313 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/binding/introspect"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ondatra/gnmi/oc/ocpath"
+	"github.com/openconfig/ygnmi/ygnmi"
+	"google.golang.org/protobuf/proto"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// lldpNeighbor is a device seen by LLDP on a local port.
+type lldpNeighbor struct {
+	localPort  string
+	systemName string
+	chassisID  string
+	remotePort string
+}
+
+// lldpInfo is the LLDP state of a device, and the speeds of its ports.
+type lldpInfo struct {
+	systemName string
+	chassisID  string
+	neighbors  []lldpNeighbor
+	speeds     map[string]opb.Port_Speed
+}
+
+// To be stubbed out by unit tests.
+var queryLLDPFn = queryLLDP
+
+// queryLLDP reads the LLDP state of a device over gNMI.
+func queryLLDP(ctx context.Context, dev introspect.Introspector) (*lldpInfo, error) {
+	conn, err := dialConn(ctx, dev, introspect.GNMI, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	c, err := ygnmi.NewClient(gpb.NewGNMIClient(conn))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &lldpInfo{speeds: make(map[string]opb.Port_Speed)}
+	lldp, err := ygnmi.Get(ctx, c, ocpath.Root().Lldp().State())
+	if err != nil {
+		return nil, fmt.Errorf("could not get LLDP state: %w", err)
+	}
+	info.systemName = lldp.GetSystemName()
+	info.chassisID = lldp.GetChassisId()
+	for intfName, intf := range lldp.Interface {
+		for _, n := range intf.Neighbor {
+			info.neighbors = append(info.neighbors, lldpNeighbor{
+				localPort:  intfName,
+				systemName: n.GetSystemName(),
+				chassisID:  n.GetChassisId(),
+				remotePort: neighborPort(n),
+			})
+		}
+	}
+
+	speeds, err := ygnmi.LookupAll(ctx, c, ocpath.Root().InterfaceAny().Ethernet().PortSpeed().State())
+	if err != nil {
+		return nil, fmt.Errorf("could not get port speeds: %w", err)
+	}
+	for _, v := range speeds {
+		speed, ok := v.Val()
+		if !ok {
+			continue
+		}
+		name := v.Path.GetElem()[1].GetKey()["name"]
+		info.speeds[name] = portSpeed(speed)
+	}
+	return info, nil
+}
+
+// neighborPort returns the name of the remote port of an LLDP neighbor.  The port ID
+// is the port name unless its type says otherwise, in which case the description of
+// the port is preferred, if any.
+func neighborPort(n *oc.Lldp_Interface_Neighbor) string {
+	switch n.GetPortIdType() {
+	case oc.Lldp_PortIdType_UNSET, oc.Lldp_PortIdType_INTERFACE_NAME, oc.Lldp_PortIdType_INTERFACE_ALIAS, oc.Lldp_PortIdType_LOCAL:
+		return n.GetPortId()
+	}
+	if desc := n.GetPortDescription(); desc != "" {
+		return desc
+	}
+	return n.GetPortId()
+}
+
+// portSpeed converts an OpenConfig port speed to a testbed port speed.
+func portSpeed(speed oc.E_IfEthernet_ETHERNET_SPEED) opb.Port_Speed {
+	name := strings.TrimPrefix(speed.String(), "SPEED_")
+	return opb.Port_Speed(opb.Port_Speed_value["S_"+name])
+}
+
+// DiscoverFile reads a seed binding file and discovers its links with Discover.
+func DiscoverFile(ctx context.Context, seedFile string) (*bindpb.Binding, []error, error) {
+	seed := &bindpb.Binding{}
+	if err := readText(seedFile, seed); err != nil {
+		return nil, nil, fmt.Errorf("unable to read seed binding file: %w", err)
+	}
+	return Discover(ctx, seed)
+}
+
+// Discover discovers the links between the devices of a seed binding from the LLDP
+// neighbors that they report over gNMI, and returns a dynamic binding of the devices
+// with the ports and links found.  The seed binding only needs the names and dial
+// options of the devices; its ports and links, if any, are kept.
+//
+// A neighbor is matched to a device by its LLDP chassis ID or system name, which
+// must be the one the device reports, or its name, or its name with or without the
+// domain.  Devices that cannot be queried, typically ATEs, are still found as the
+// neighbors of the others, so their links to devices that can be queried are
+// discovered.  The devices that cannot be queried and the neighbors that match no
+// device are returned as warnings.
+func Discover(ctx context.Context, seed *bindpb.Binding) (*bindpb.Binding, []error, error) {
+	resolved := proto.Clone(seed).(*bindpb.Binding)
+	if err := resolveBinding(resolved); err != nil {
+		return nil, nil, fmt.Errorf("unable to resolve binding options: %w", err)
+	}
+	r := resolver{resolved}
+
+	var warnings []error
+	infos := make(map[string]*lldpInfo)
+	for _, d := range resolved.GetDuts() {
+		info, err := queryLLDPFn(ctx, &staticDUT{r: r, dev: d})
+		if err != nil {
+			warnings = append(warnings, fmt.Errorf("could not query DUT %q: %w", d.GetName(), err))
+			continue
+		}
+		infos[d.GetName()] = info
+	}
+	for _, a := range resolved.GetAtes() {
+		info, err := queryLLDPFn(ctx, &staticATE{r: r, dev: a})
+		if err != nil {
+			warnings = append(warnings, fmt.Errorf("could not query ATE %q: %w", a.GetName(), err))
+			continue
+		}
+		infos[a.GetName()] = info
+	}
+
+	devs := append(append([]*bindpb.Device{}, seed.GetDuts()...), seed.GetAtes()...)
+	links, speeds, linkWarnings := lldpLinks(devs, infos)
+	warnings = append(warnings, linkWarnings...)
+	if len(links) == 0 {
+		return nil, warnings, fmt.Errorf("no links found between the devices of the binding")
+	}
+
+	dyn := proto.Clone(seed).(*bindpb.Binding)
+	dyn.Dynamic = true
+	for _, dev := range append(append([]*bindpb.Device{}, dyn.GetDuts()...), dyn.GetAtes()...) {
+		dev.Id = ""
+		known := make(map[string]bool)
+		for _, p := range dev.GetPorts() {
+			p.Id = ""
+			known[p.GetName()] = true
+		}
+		for _, name := range sortedKeys(speeds[dev.GetName()]) {
+			if !known[name] {
+				dev.Ports = append(dev.Ports, &bindpb.Port{Name: name, Speed: speeds[dev.GetName()][name]})
+			}
+		}
+	}
+	for _, l := range links {
+		if !hasLink(dyn.GetLinks(), l) {
+			dyn.Links = append(dyn.Links, l)
+		}
+	}
+	return dyn, warnings, nil
+}
+
+// lldpLinks returns the links between the devices that their LLDP neighbors show, in
+// order, and the speeds of the ports of the links, keyed by device and port name.
+func lldpLinks(devs []*bindpb.Device, infos map[string]*lldpInfo) ([]*bindpb.Link, map[string]map[string]opb.Port_Speed, []error) {
+	var warnings []error
+	byChassis := make(map[string]string)
+	bySysName := make(map[string]string)
+	for name, info := range infos {
+		if info.chassisID != "" {
+			byChassis[info.chassisID] = name
+		}
+		if info.systemName != "" {
+			bySysName[strings.ToLower(info.systemName)] = name
+		}
+	}
+	match := func(n lldpNeighbor) string {
+		if name, ok := byChassis[n.chassisID]; ok && n.chassisID != "" {
+			return name
+		}
+		sysName := strings.ToLower(n.systemName)
+		if name, ok := bySysName[sysName]; ok && sysName != "" {
+			return name
+		}
+		for _, dev := range devs {
+			name := strings.ToLower(dev.GetName())
+			if sysName == name || strings.HasPrefix(sysName, name+".") || strings.HasPrefix(name, sysName+".") {
+				return dev.GetName()
+			}
+		}
+		return ""
+	}
+
+	speeds := make(map[string]map[string]opb.Port_Speed)
+	addPort := func(dev, port string, speed opb.Port_Speed) {
+		if speeds[dev] == nil {
+			speeds[dev] = make(map[string]opb.Port_Speed)
+		}
+		if speed != opb.Port_SPEED_UNSPECIFIED || speeds[dev][port] == opb.Port_SPEED_UNSPECIFIED {
+			speeds[dev][port] = speed
+		}
+	}
+	seen := make(map[string]*bindpb.Link)
+	for _, local := range sortedKeys(infos) {
+		info := infos[local]
+		for _, n := range info.neighbors {
+			remote := match(n)
+			if remote == "" {
+				warnings = append(warnings, fmt.Errorf("neighbor %q on %s:%s is not a device of the binding", n.systemName, local, n.localPort))
+				continue
+			}
+			if remote == local {
+				continue
+			}
+			speed := info.speeds[n.localPort]
+			addPort(local, n.localPort, speed)
+			// The speed of the remote port is the speed of the link, unless the remote
+			// device reports its own.
+			if rinfo, ok := infos[remote]; ok && rinfo.speeds[n.remotePort] != opb.Port_SPEED_UNSPECIFIED {
+				speed = rinfo.speeds[n.remotePort]
+			}
+			addPort(remote, n.remotePort, speed)
+			a, b := local+":"+n.localPort, remote+":"+n.remotePort
+			if b < a {
+				a, b = b, a
+			}
+			seen[a+" "+b] = &bindpb.Link{A: a, B: b}
+		}
+	}
+	var links []*bindpb.Link
+	for _, k := range sortedKeys(seen) {
+		links = append(links, seen[k])
+	}
+	return links, speeds, warnings
+}
+
+func hasLink(links []*bindpb.Link, l *bindpb.Link) bool {
+	for _, x := range links {
+		if (x.GetA() == l.GetA() && x.GetB() == l.GetB()) || (x.GetA() == l.GetB() && x.GetB() == l.GetA()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Solve solves a dynamic binding for the testbed, and returns the equivalent static
+// binding: the devices and ports assigned to the testbed, with their IDs set.  The
+// devices and ports that the testbed does not use are left out.
+func Solve(ctx context.Context, tb *opb.Testbed, dyn *bindpb.Binding) (*bindpb.Binding, error) {
+	resv, err := dynamicReservation(ctx, tb, resolver{dyn})
+	if err != nil {
+		return nil, err
+	}
+	bind := func(tdev *opb.Device, bdev *bindpb.Device, bports map[string]*binding.Port) *bindpb.Device {
+		dev := proto.Clone(bdev).(*bindpb.Device)
+		dev.Id = tdev.GetId()
+		dev.Ports = nil
+		for _, tport := range tdev.GetPorts() {
+			bport := bports[tport.GetId()]
+			dev.Ports = append(dev.Ports, &bindpb.Port{
+				Id:    tport.GetId(),
+				Name:  bport.Name,
+				Speed: bport.Speed,
+				Pmd:   bport.PMD,
+			})
+		}
+		return dev
+	}
+	static := &bindpb.Binding{}
+	if dyn.GetOptions() != nil {
+		static.Options = proto.Clone(dyn.GetOptions()).(*bindpb.Options)
+	}
+	for _, tdut := range tb.GetDuts() {
+		d := resv.DUTs[tdut.GetId()].(*staticDUT)
+		static.Duts = append(static.Duts, bind(tdut, d.dev, d.Ports()))
+	}
+	for _, tate := range tb.GetAtes() {
+		a := resv.ATEs[tate.GetId()].(*staticATE)
+		static.Ates = append(static.Ates, bind(tate, a.dev, a.Ports()))
+	}
+	return static, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	"github.com/openconfig/ondatra/binding/introspect"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	opb "github.com/openconfig/ondatra/proto"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestDiscover(t *testing.T) {
+	infos := map[string]*lldpInfo{
+		"dut1.lab": {
+			systemName: "dut1",
+			chassisID:  "00:00:5e:00:53:01",
+			neighbors: []lldpNeighbor{
+				{localPort: "Ethernet1", systemName: "ate1", remotePort: "1/1"},
+				{localPort: "Ethernet2", systemName: "ATE1.lab", remotePort: "1/2"},
+				{localPort: "Ethernet3", systemName: "dut2.lab.example", remotePort: "Ethernet9"},
+				{localPort: "Ethernet4", systemName: "switch", remotePort: "Gi0/1"},
+			},
+			speeds: map[string]opb.Port_Speed{"Ethernet1": opb.Port_S_100GB},
+		},
+		"dut2": {
+			systemName: "dut2.lab.example",
+			neighbors: []lldpNeighbor{
+				{localPort: "Ethernet9", chassisID: "00:00:5e:00:53:01", remotePort: "Ethernet3"},
+			},
+		},
+	}
+	origQuery := queryLLDPFn
+	queryLLDPFn = func(_ context.Context, dev introspect.Introspector) (*lldpInfo, error) {
+		var name string
+		switch d := dev.(type) {
+		case *staticDUT:
+			name = d.dev.GetName()
+		case *staticATE:
+			name = d.dev.GetName()
+		}
+		if info, ok := infos[name]; ok {
+			return info, nil
+		}
+		return nil, errors.New("no gNMI")
+	}
+	defer func() { queryLLDPFn = origQuery }()
+
+	seed := &bindpb.Binding{
+		Options: &bindpb.Options{Username: "admin", Password: "${DISCOVER_TEST_PASSWORD}"},
+		Duts:    []*bindpb.Device{{Name: "dut1.lab"}, {Name: "dut2"}},
+		Ates:    []*bindpb.Device{{Id: "ate", Name: "ate1", Ports: []*bindpb.Port{{Id: "port1", Name: "1/1"}}}},
+	}
+	t.Setenv("DISCOVER_TEST_PASSWORD", "secret")
+	dyn, warnings, err := Discover(context.Background(), seed)
+	if err != nil {
+		t.Fatalf("Discover() got err: %v", err)
+	}
+	if len(warnings) != 2 {
+		t.Errorf("Discover() got warnings %v, want the ATE query and the switch", warnings)
+	}
+
+	wantDyn := &bindpb.Binding{
+		Dynamic: true,
+		// The options are not resolved in the output.
+		Options: &bindpb.Options{Username: "admin", Password: "${DISCOVER_TEST_PASSWORD}"},
+		Duts: []*bindpb.Device{{
+			Name: "dut1.lab",
+			Ports: []*bindpb.Port{
+				{Name: "Ethernet1", Speed: opb.Port_S_100GB},
+				{Name: "Ethernet2"},
+				{Name: "Ethernet3"},
+			},
+		}, {
+			Name:  "dut2",
+			Ports: []*bindpb.Port{{Name: "Ethernet9"}},
+		}},
+		Ates: []*bindpb.Device{{
+			Name: "ate1",
+			Ports: []*bindpb.Port{
+				{Name: "1/1"},
+				{Name: "1/2"},
+			},
+		}},
+		Links: []*bindpb.Link{
+			{A: "ate1:1/1", B: "dut1.lab:Ethernet1"},
+			{A: "ate1:1/2", B: "dut1.lab:Ethernet2"},
+			{A: "dut1.lab:Ethernet3", B: "dut2:Ethernet9"},
+		},
+	}
+	if diff := cmp.Diff(wantDyn, dyn, protocmp.Transform()); diff != "" {
+		t.Errorf("Discover() got unexpected binding (-want +got):\n%s", diff)
+	}
+
+	tb := &opb.Testbed{
+		Duts:  []*opb.Device{{Id: "dut", Ports: []*opb.Port{{Id: "port1", Speed: opb.Port_S_100GB}}}},
+		Ates:  []*opb.Device{{Id: "ate", Ports: []*opb.Port{{Id: "port1"}}}},
+		Links: []*opb.Link{{A: "dut:port1", B: "ate:port1"}},
+	}
+	static, err := Solve(context.Background(), tb, dyn)
+	if err != nil {
+		t.Fatalf("Solve() got err: %v", err)
+	}
+	wantStatic := &bindpb.Binding{
+		Options: &bindpb.Options{Username: "admin", Password: "${DISCOVER_TEST_PASSWORD}"},
+		Duts: []*bindpb.Device{{
+			Id:    "dut",
+			Name:  "dut1.lab",
+			Ports: []*bindpb.Port{{Id: "port1", Name: "Ethernet1", Speed: opb.Port_S_100GB}},
+		}},
+		Ates: []*bindpb.Device{{
+			Id:    "ate",
+			Name:  "ate1",
+			Ports: []*bindpb.Port{{Id: "port1", Name: "1/1"}},
+		}},
+	}
+	if diff := cmp.Diff(wantStatic, static, protocmp.Transform()); diff != "" {
+		t.Errorf("Solve() got unexpected binding (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiscover_NoLinks(t *testing.T) {
+	origQuery := queryLLDPFn
+	queryLLDPFn = func(context.Context, introspect.Introspector) (*lldpInfo, error) {
+		return &lldpInfo{}, nil
+	}
+	defer func() { queryLLDPFn = origQuery }()
+
+	seed := &bindpb.Binding{Duts: []*bindpb.Device{{Name: "dut1"}}}
+	if _, _, err := Discover(context.Background(), seed); err == nil {
+		t.Errorf("Discover() got no error, want error")
+	}
+}
+
+func TestNeighborPort(t *testing.T) {
+	tests := []struct {
+		desc string
+		n    *oc.Lldp_Interface_Neighbor
+		want string
+	}{{
+		desc: "interface name",
+		n:    &oc.Lldp_Interface_Neighbor{PortId: ygot.String("Ethernet1"), PortIdType: oc.Lldp_PortIdType_INTERFACE_NAME, PortDescription: ygot.String("to dut")},
+		want: "Ethernet1",
+	}, {
+		desc: "mac address",
+		n:    &oc.Lldp_Interface_Neighbor{PortId: ygot.String("00:00:5e:00:53:01"), PortIdType: oc.Lldp_PortIdType_MAC_ADDRESS, PortDescription: ygot.String("Ethernet1")},
+		want: "Ethernet1",
+	}, {
+		desc: "mac address without description",
+		n:    &oc.Lldp_Interface_Neighbor{PortId: ygot.String("00:00:5e:00:53:01"), PortIdType: oc.Lldp_PortIdType_MAC_ADDRESS},
+		want: "00:00:5e:00:53:01",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := neighborPort(tt.n); got != tt.want {
+				t.Errorf("neighborPort() got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPortSpeed(t *testing.T) {
+	for speed, want := range map[oc.E_IfEthernet_ETHERNET_SPEED]opb.Port_Speed{
+		oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB:   opb.Port_S_100GB,
+		oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB:   opb.Port_S_400GB,
+		oc.IfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN: opb.Port_SPEED_UNSPECIFIED,
+	} {
+		if got := portSpeed(speed); got != want {
+			t.Errorf("portSpeed(%v) got %v, want %v", speed, got, want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakedut
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openconfig/ondatra/binding"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// Binding reserves a fake DUT for every DUT of the testbed.  The ports of a fake DUT
+// are named after their IDs.  Testbeds with ATEs cannot be reserved.
+type Binding struct {
+	resv *binding.Reservation
+}
+
+var _ binding.Binding = (*Binding)(nil)
+
+// Reserve starts the fake DUTs of the testbed.
+func (b *Binding) Reserve(_ context.Context, tb *opb.Testbed, _, _ time.Duration, _ map[string]string) (*binding.Reservation, error) {
+	if len(tb.GetAtes()) > 0 {
+		return nil, fmt.Errorf("fake binding cannot reserve the %d ATEs of the testbed", len(tb.GetAtes()))
+	}
+	resv := &binding.Reservation{ID: "FAKE", DUTs: make(map[string]binding.DUT)}
+	for _, td := range tb.GetDuts() {
+		dims := &binding.Dims{
+			Name:            td.GetId(),
+			Vendor:          td.GetVendor(),
+			HardwareModel:   td.GetHardwareModel(),
+			SoftwareVersion: td.GetSoftwareVersion(),
+			Ports:           make(map[string]*binding.Port),
+		}
+		for _, tp := range td.GetPorts() {
+			dims.Ports[tp.GetId()] = &binding.Port{Name: tp.GetId(), Speed: tp.GetSpeed()}
+		}
+		resv.DUTs[td.GetId()] = New(dims)
+	}
+	b.resv = resv
+	return resv, nil
+}
+
+// Release closes the fake DUTs.
+func (b *Binding) Release(context.Context) error {
+	if b.resv == nil {
+		return nil
+	}
+	for _, d := range b.resv.DUTs {
+		d.(*DUT).Close()
+	}
+	b.resv = nil
+	return nil
+}
+
+// FetchReservation fails, since fake DUTs only live in the test binary.
+func (b *Binding) FetchReservation(context.Context, string) (*binding.Reservation, error) {
+	return nil, errors.New("fake binding cannot fetch reservations")
+}
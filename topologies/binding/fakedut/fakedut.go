@@ -0,0 +1,263 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fakedut implements Ondatra DUTs that serve their gRPC services
+// in-process, so that code that needs a DUT can be unit tested without a device.
+//
+// The gNMI service serves Get, Set and Subscribe from an in-memory OpenConfig tree,
+// which is validated against the schema on every Set.  The config leaves are also
+// reported under their state paths.  Every other service, such as gNOI and gRIBI,
+// accepts any call; its responses can be scripted with DUT.Handle.
+//
+// A package test reserves fake DUTs with Setup and then uses them through Ondatra:
+//
+//	func TestFoo(t *testing.T) {
+//		fake := fakedut.New(&binding.Dims{Name: "dut1", Vendor: opb.Device_ARISTA})
+//		fakedut.Setup(t, map[string]*fakedut.DUT{"dut": fake})
+//		dut := ondatra.DUT(t, "dut")
+//		gnmi.Replace(t, dut, gnmi.OC().System().Hostname().Config(), "foo")
+//		...
+//	}
+package fakedut
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/openconfig/gnoigo"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/fakebind"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	gnpsipb "github.com/openconfig/gnpsi/proto/gnpsi"
+	accpb "github.com/openconfig/gnsi/acctz"
+	authzpb "github.com/openconfig/gnsi/authz"
+	certzpb "github.com/openconfig/gnsi/certz"
+	credpb "github.com/openconfig/gnsi/credentialz"
+	pathzpb "github.com/openconfig/gnsi/pathz"
+	grpb "github.com/openconfig/gribi/v1/proto/service"
+	p4pb "github.com/p4lang/p4runtime/go/p4/v1"
+)
+
+// DUT is a fake DUT that serves its gRPC services in-process.
+type DUT struct {
+	*binding.AbstractDUT
+	tree *tree
+	rpcs *rpcs
+	srv  *grpc.Server
+	lis  *bufconn.Listener
+
+	mu      sync.Mutex
+	conns   []*grpc.ClientConn
+	configs []string
+}
+
+var _ binding.DUT = (*DUT)(nil)
+
+// New starts a fake DUT with the given dimensions and an empty OpenConfig tree.
+func New(dims *binding.Dims) *DUT {
+	d := &DUT{
+		AbstractDUT: &binding.AbstractDUT{Dims: dims},
+		tree:        newTree(),
+		rpcs:        newRPCs(),
+		lis:         bufconn.Listen(1 << 20),
+	}
+	d.srv = grpc.NewServer(grpc.UnknownServiceHandler(d.rpcs.serve))
+	gpb.RegisterGNMIServer(d.srv, &gnmiServer{tree: d.tree, rpcs: d.rpcs})
+	go d.srv.Serve(d.lis)
+	return d
+}
+
+// Setup reserves the DUTs for the test, keyed by their IDs in the testbed, so that
+// ondatra.DUT returns them.  The reservation is released and the DUTs are closed
+// when the test ends.
+func Setup(t testing.TB, duts map[string]*DUT) {
+	t.Helper()
+	resv := &binding.Reservation{ID: "FAKE", DUTs: make(map[string]binding.DUT)}
+	for id, d := range duts {
+		resv.DUTs[id] = d
+	}
+	b := fakebind.Setup().WithReservation(resv)
+	t.Cleanup(func() {
+		b.WithReservation(nil)
+		for _, d := range duts {
+			d.Close()
+		}
+	})
+}
+
+// Close closes the connections to the DUT and stops its services.
+func (d *DUT) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, conn := range d.conns {
+		conn.Close()
+	}
+	d.conns = nil
+	d.srv.Stop()
+}
+
+// Update modifies the OpenConfig tree of the DUT, e.g. to set the state leaves that
+// the code under test reads.  The modified tree must be valid.  Subscriptions are
+// notified of the leaves that changed.
+func (d *DUT) Update(f func(root *oc.Root)) error {
+	return d.tree.update(func(root *oc.Root) error {
+		f(root)
+		return nil
+	})
+}
+
+// Root returns a copy of the OpenConfig tree of the DUT.
+func (d *DUT) Root() *oc.Root {
+	return d.tree.copy()
+}
+
+// Handle scripts the responses to a method, given by its full gRPC name, e.g.
+// "/gnoi.system.System/Reboot".  The handler is called with each request received
+// and returns the responses to send back, or the error to end the call with.  A
+// unary method must be given at most one response; if it is given none, it
+// responds with an empty message.  A handler of the gNMI Get or Set replaces the
+// fake tree only when it returns a response or an error.  Subscribe cannot be
+// scripted.
+func (d *DUT) Handle(method string, h Handler) {
+	d.rpcs.handle(method, h)
+}
+
+// Requests returns the requests received by a method, given by its full gRPC name,
+// in the order they were received.
+func (d *DUT) Requests(method string) []proto.Message {
+	return d.rpcs.received(method)
+}
+
+// PushConfig records the config, which can be read back with PushedConfigs.
+func (d *DUT) PushConfig(_ context.Context, config string, reset bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if reset {
+		d.configs = nil
+	}
+	d.configs = append(d.configs, config)
+	return nil
+}
+
+// PushedConfigs returns the configs pushed since the last reset.
+func (d *DUT) PushedConfigs() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.configs...)
+}
+
+// dial connects to the services of the DUT.  The options of the caller are appended
+// to the options that reach the in-process server.
+func (d *DUT) dial(opts []grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return d.lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, opts...)
+	conn, err := grpc.NewClient("passthrough:///"+d.Name(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.conns = append(d.conns, conn)
+	return conn, nil
+}
+
+// DialGNMI connects to the fake gNMI service.
+func (d *DUT) DialGNMI(_ context.Context, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
+	conn, err := d.dial(opts)
+	if err != nil {
+		return nil, err
+	}
+	return gpb.NewGNMIClient(conn), nil
+}
+
+// DialGNOI connects to the scripted gNOI services.
+func (d *DUT) DialGNOI(_ context.Context, opts ...grpc.DialOption) (gnoigo.Clients, error) {
+	conn, err := d.dial(opts)
+	if err != nil {
+		return nil, err
+	}
+	return gnoigo.NewClients(conn), nil
+}
+
+// DialGNSI connects to the scripted gNSI services.
+func (d *DUT) DialGNSI(_ context.Context, opts ...grpc.DialOption) (binding.GNSIClients, error) {
+	conn, err := d.dial(opts)
+	if err != nil {
+		return nil, err
+	}
+	return gnsiConn{conn: conn}, nil
+}
+
+// DialGNPSI connects to the scripted gNPSI service.
+func (d *DUT) DialGNPSI(_ context.Context, opts ...grpc.DialOption) (gnpsipb.GNPSIClient, error) {
+	conn, err := d.dial(opts)
+	if err != nil {
+		return nil, err
+	}
+	return gnpsipb.NewGNPSIClient(conn), nil
+}
+
+// DialGRIBI connects to the scripted gRIBI service.
+func (d *DUT) DialGRIBI(_ context.Context, opts ...grpc.DialOption) (grpb.GRIBIClient, error) {
+	conn, err := d.dial(opts)
+	if err != nil {
+		return nil, err
+	}
+	return grpb.NewGRIBIClient(conn), nil
+}
+
+// DialP4RT connects to the scripted P4Runtime service.
+func (d *DUT) DialP4RT(_ context.Context, opts ...grpc.DialOption) (p4pb.P4RuntimeClient, error) {
+	conn, err := d.dial(opts)
+	if err != nil {
+		return nil, err
+	}
+	return p4pb.NewP4RuntimeClient(conn), nil
+}
+
+// gnsiConn implements the stub builder needed by the Ondatra
+// binding.Binding interface.
+type gnsiConn struct {
+	*binding.AbstractGNSIClients
+	conn *grpc.ClientConn
+}
+
+func (g gnsiConn) Authz() authzpb.AuthzClient { return authzpb.NewAuthzClient(g.conn) }
+func (g gnsiConn) Pathz() pathzpb.PathzClient {
+	return pathzpb.NewPathzClient(g.conn)
+}
+func (g gnsiConn) Certz() certzpb.CertzClient { return certzpb.NewCertzClient(g.conn) }
+func (g gnsiConn) Credentialz() credpb.CredentialzClient {
+	return credpb.NewCredentialzClient(g.conn)
+}
+func (g gnsiConn) Acctz() accpb.AcctzClient {
+	return accpb.NewAcctzClient(g.conn)
+}
+func (g gnsiConn) AcctzStream() accpb.AcctzStreamClient {
+	return accpb.NewAcctzStreamClient(g.conn)
+}
+
+var _ = binding.GNSIClients(gnsiConn{})
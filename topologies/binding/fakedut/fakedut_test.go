@@ -0,0 +1,285 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakedut
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ondatra"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/gnmi"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/testt"
+	"github.com/openconfig/ygnmi/ygnmi"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	spb "github.com/openconfig/gnoi/system"
+	grpb "github.com/openconfig/gribi/v1/proto/service"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func setup(t *testing.T) (*DUT, *ondatra.DUTDevice) {
+	t.Helper()
+	fake := New(&binding.Dims{Name: "dut1", Vendor: opb.Device_ARISTA})
+	Setup(t, map[string]*DUT{"dut": fake})
+	return fake, ondatra.DUT(t, "dut")
+}
+
+func TestGNMIConfig(t *testing.T) {
+	fake, dut := setup(t)
+
+	intf := &oc.Interface{Name: ygot.String("eth1"), Description: ygot.String("uplink"), Type: oc.IETFInterfaces_InterfaceType_ethernetCsmacd}
+	gnmi.Replace(t, dut, gnmi.OC().Interface("eth1").Config(), intf)
+	gnmi.Update(t, dut, gnmi.OC().System().Hostname().Config(), "dut1")
+
+	if got, want := gnmi.Get(t, dut, gnmi.OC().Interface("eth1").Description().Config()), "uplink"; got != want {
+		t.Errorf("Get config description got %q, want %q", got, want)
+	}
+	// Config leaves are also reported as state.
+	if got, want := gnmi.Get(t, dut, gnmi.OC().Interface("eth1").Description().State()), "uplink"; got != want {
+		t.Errorf("Get state description got %q, want %q", got, want)
+	}
+	gotIntf := gnmi.Get(t, dut, gnmi.OC().Interface("eth1").Config())
+	if diff := cmp.Diff(intf, gotIntf); diff != "" {
+		t.Errorf("Get interface differs (-want +got):\n%s", diff)
+	}
+	if got := gnmi.GetAll(t, dut, gnmi.OC().InterfaceAny().Name().State()); len(got) != 1 || got[0] != "eth1" {
+		t.Errorf("GetAll interface names got %v, want [eth1]", got)
+	}
+	if got, want := fake.Root().GetSystem().GetHostname(), "dut1"; got != want {
+		t.Errorf("Root hostname got %q, want %q", got, want)
+	}
+
+	gnmi.Delete(t, dut, gnmi.OC().Interface("eth1").Config())
+	if _, ok := gnmi.Lookup(t, dut, gnmi.OC().Interface("eth1").Description().State()).Val(); ok {
+		t.Errorf("Description still present after delete")
+	}
+	if got := len(fake.Requests(setMethod)); got != 3 {
+		t.Errorf("Recorded %d Set requests, want 3", got)
+	}
+}
+
+func TestGNMIInvalid(t *testing.T) {
+	_, dut := setup(t)
+	// A hostname must be a domain name.
+	err := testt.ExpectFatal(t, func(t testing.TB) {
+		gnmi.Replace(t, dut, gnmi.OC().System().Hostname().Config(), "not a hostname!")
+	})
+	if !strings.Contains(err, "InvalidArgument") {
+		t.Errorf("Replace of an invalid hostname got error %q, want InvalidArgument", err)
+	}
+}
+
+func TestGNMIWatch(t *testing.T) {
+	fake, dut := setup(t)
+	if err := fake.Update(func(root *oc.Root) {
+		root.GetOrCreateInterface("eth1").GetOrCreateCounters().InPkts = ygot.Uint64(1)
+	}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	counter := gnmi.OC().Interface("eth1").Counters().InPkts().State()
+	if got := gnmi.Get(t, dut, counter); got != 1 {
+		t.Errorf("Get counter got %d, want 1", got)
+	}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		fake.Update(func(root *oc.Root) {
+			root.GetOrCreateInterface("eth1").GetOrCreateCounters().InPkts = ygot.Uint64(5)
+		})
+	}()
+	if _, ok := gnmi.Watch(t, dut, counter, 10*time.Second, func(v *ygnmi.Value[uint64]) bool {
+		got, ok := v.Val()
+		return ok && got == 5
+	}).Await(t); !ok {
+		t.Errorf("Watch did not see the counter change to 5")
+	}
+}
+
+func TestGNMIScripted(t *testing.T) {
+	fake, dut := setup(t)
+	fake.Handle(setMethod, func(proto.Message) ([]proto.Message, error) {
+		return nil, status.Error(codes.PermissionDenied, "no")
+	})
+	err := testt.ExpectFatal(t, func(t testing.TB) {
+		gnmi.Replace(t, dut, gnmi.OC().System().Hostname().Config(), "dut1")
+	})
+	if err == "" {
+		t.Errorf("Replace did not fail with the scripted error")
+	}
+	if _, ok := gnmi.Lookup(t, dut, gnmi.OC().System().Hostname().State()).Val(); ok {
+		t.Errorf("Hostname set despite the scripted error")
+	}
+}
+
+func TestGNOI(t *testing.T) {
+	fake, dut := setup(t)
+	ctx := context.Background()
+	system := dut.RawAPIs().GNOI(t).System()
+
+	// Without a handler, unary methods respond with an empty message.
+	if _, err := system.Reboot(ctx, &spb.RebootRequest{Method: spb.RebootMethod_COLD}); err != nil {
+		t.Fatalf("Reboot failed: %v", err)
+	}
+	want := []proto.Message{&spb.RebootRequest{Method: spb.RebootMethod_COLD}}
+	if diff := cmp.Diff(want, fake.Requests("/gnoi.system.System/Reboot"), protocmp.Transform()); diff != "" {
+		t.Errorf("Reboot requests differ (-want +got):\n%s", diff)
+	}
+
+	fake.Handle("/gnoi.system.System/Time", func(proto.Message) ([]proto.Message, error) {
+		return []proto.Message{&spb.TimeResponse{Time: 42}}, nil
+	})
+	resp, err := system.Time(ctx, &spb.TimeRequest{})
+	if err != nil {
+		t.Fatalf("Time failed: %v", err)
+	}
+	if got := resp.GetTime(); got != 42 {
+		t.Errorf("Time got %d, want 42", got)
+	}
+
+	fake.Handle("/gnoi.system.System/Ping", func(proto.Message) ([]proto.Message, error) {
+		return []proto.Message{&spb.PingResponse{Sequence: 1}, &spb.PingResponse{Sequence: 2}}, nil
+	})
+	ping, err := system.Ping(ctx, &spb.PingRequest{Destination: "192.0.2.1"})
+	if err != nil {
+		t.Fatalf("Ping failed: %v", err)
+	}
+	var seqs []int32
+	for {
+		resp, err := ping.Recv()
+		if err != nil {
+			break
+		}
+		seqs = append(seqs, resp.GetSequence())
+	}
+	if diff := cmp.Diff([]int32{1, 2}, seqs); diff != "" {
+		t.Errorf("Ping sequences differ (-want +got):\n%s", diff)
+	}
+}
+
+func TestGRIBI(t *testing.T) {
+	fake, dut := setup(t)
+	fake.Handle("/gribi.gRIBI/Modify", func(req proto.Message) ([]proto.Message, error) {
+		var results []*grpb.AFTResult
+		for _, op := range req.(*grpb.ModifyRequest).GetOperation() {
+			results = append(results, &grpb.AFTResult{Id: op.GetId(), Status: grpb.AFTResult_FIB_PROGRAMMED})
+		}
+		return []proto.Message{&grpb.ModifyResponse{Result: results}}, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := dut.RawAPIs().GRIBI(t).Modify(ctx)
+	if err != nil {
+		t.Fatalf("Modify failed: %v", err)
+	}
+	if err := stream.Send(&grpb.ModifyRequest{Operation: []*grpb.AFTOperation{{Id: 7}}}); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv failed: %v", err)
+	}
+	want := &grpb.ModifyResponse{Result: []*grpb.AFTResult{{Id: 7, Status: grpb.AFTResult_FIB_PROGRAMMED}}}
+	if diff := cmp.Diff(want, resp, protocmp.Transform()); diff != "" {
+		t.Errorf("Modify response differs (-want +got):\n%s", diff)
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	path := &gpb.Path{Elem: []*gpb.PathElem{
+		{Name: "interfaces"},
+		{Name: "interface", Key: map[string]string{"name": "eth1"}},
+		{Name: "state"},
+		{Name: "mtu"},
+	}}
+	tests := []struct {
+		desc  string
+		query *gpb.Path
+		want  bool
+	}{{
+		desc:  "root",
+		query: &gpb.Path{Origin: "openconfig"},
+		want:  true,
+	}, {
+		desc:  "prefix",
+		query: &gpb.Path{Elem: []*gpb.PathElem{{Name: "interfaces"}, {Name: "interface", Key: map[string]string{"name": "eth1"}}}},
+		want:  true,
+	}, {
+		desc:  "wildcard key",
+		query: &gpb.Path{Elem: []*gpb.PathElem{{Name: "interfaces"}, {Name: "interface", Key: map[string]string{"name": "*"}}, {Name: "state"}, {Name: "mtu"}}},
+		want:  true,
+	}, {
+		desc:  "module prefix",
+		query: &gpb.Path{Elem: []*gpb.PathElem{{Name: "openconfig-interfaces:interfaces"}}},
+		want:  true,
+	}, {
+		desc:  "other key",
+		query: &gpb.Path{Elem: []*gpb.PathElem{{Name: "interfaces"}, {Name: "interface", Key: map[string]string{"name": "eth2"}}}},
+		want:  false,
+	}, {
+		desc:  "other origin",
+		query: &gpb.Path{Origin: "cli"},
+		want:  false,
+	}, {
+		desc:  "longer",
+		query: &gpb.Path{Elem: []*gpb.PathElem{{Name: "interfaces"}, {Name: "interface"}, {Name: "state"}, {Name: "mtu"}, {Name: "x"}}},
+		want:  false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := matchPath(tt.query, path); got != tt.want {
+				t.Errorf("matchPath() got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBinding(t *testing.T) {
+	b := &Binding{}
+	tb := &opb.Testbed{Duts: []*opb.Device{{
+		Id:     "dut",
+		Vendor: opb.Device_CISCO,
+		Ports:  []*opb.Port{{Id: "port1"}, {Id: "port2"}},
+	}}}
+	resv, err := b.Reserve(context.Background(), tb, 0, 0, nil)
+	if err != nil {
+		t.Fatalf("Reserve failed: %v", err)
+	}
+	defer b.Release(context.Background())
+	dut, ok := resv.DUTs["dut"]
+	if !ok {
+		t.Fatalf("Reservation has no DUT %q: %v", "dut", resv)
+	}
+	if got, want := dut.Vendor(), opb.Device_CISCO; got != want {
+		t.Errorf("Vendor got %v, want %v", got, want)
+	}
+	if got := len(dut.Ports()); got != 2 {
+		t.Errorf("DUT has %d ports, want 2", got)
+	}
+
+	tb.Ates = []*opb.Device{{Id: "ate"}}
+	if _, err := (&Binding{}).Reserve(context.Background(), tb, 0, 0, nil); err == nil {
+		t.Errorf("Reserve of a testbed with ATEs did not fail")
+	}
+}
@@ -0,0 +1,417 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakedut
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// tree is the OpenConfig tree of a DUT, with its leaves cached for the queries.
+type tree struct {
+	mu     sync.Mutex
+	root   *oc.Root
+	leaves *leaves
+	// changed is closed and replaced whenever the tree changes.
+	changed chan struct{}
+}
+
+func newTree() *tree {
+	root := &oc.Root{}
+	l, err := newLeaves(root)
+	if err != nil {
+		panic(fmt.Sprintf("cannot list the leaves of an empty tree: %v", err))
+	}
+	return &tree{root: root, leaves: l, changed: make(chan struct{})}
+}
+
+// snapshot returns the leaves of the tree, and a channel that is closed when the
+// tree changes after them.
+func (t *tree) snapshot() (*leaves, <-chan struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.leaves, t.changed
+}
+
+func (t *tree) copy() *oc.Root {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	c, err := ygot.DeepCopy(t.root)
+	if err != nil {
+		panic(fmt.Sprintf("cannot copy the tree: %v", err))
+	}
+	return c.(*oc.Root)
+}
+
+// update modifies a copy of the tree, and replaces the tree with it if it is valid.
+func (t *tree) update(f func(root *oc.Root) error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	c, err := ygot.DeepCopy(t.root)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot copy the tree: %v", err)
+	}
+	root := c.(*oc.Root)
+	if err := f(root); err != nil {
+		return err
+	}
+	// A partial config may refer to leaves that the test did not set.
+	if err := root.Validate(&ytypes.LeafrefOptions{IgnoreMissingData: true}); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid config: %v", err)
+	}
+	l, err := newLeaves(root)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot list the leaves of the tree: %v", err)
+	}
+	t.root, t.leaves = root, l
+	close(t.changed)
+	t.changed = make(chan struct{})
+	return nil
+}
+
+// leaves are the leaves of a tree, sorted by path.  Config leaves are listed under
+// both their config and state paths.
+type leaves struct {
+	updates []*gpb.Update
+	// config marks the paths of the config leaves.
+	config map[string]bool
+}
+
+func newLeaves(root *oc.Root) (*leaves, error) {
+	l := &leaves{config: make(map[string]bool)}
+	byPath := make(map[string]*gpb.Update)
+	// The oc structs prefer the state paths; the shadow paths are the config paths.
+	for _, shadow := range []bool{false, true} {
+		n, err := ygot.Diff(&oc.Root{}, root, &ygot.DiffPathOpt{PreferShadowPath: shadow})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range n.GetUpdate() {
+			p := pathString(u.GetPath())
+			if _, ok := byPath[p]; ok {
+				continue
+			}
+			byPath[p] = u
+			l.config[p] = shadow
+		}
+	}
+	for _, p := range sortedKeys(byPath) {
+		l.updates = append(l.updates, byPath[p])
+	}
+	return l, nil
+}
+
+// match returns the leaves that match any of the queries, keyed by path.  The paths of
+// the returned leaves have the origin of the query.
+func (l *leaves) match(queries []*gpb.Path, dataType gpb.GetRequest_DataType) map[string]*gpb.Update {
+	m := make(map[string]*gpb.Update)
+	for _, u := range l.updates {
+		p := pathString(u.GetPath())
+		switch {
+		case dataType == gpb.GetRequest_CONFIG && !l.config[p]:
+			continue
+		case dataType == gpb.GetRequest_STATE && l.config[p]:
+			continue
+		}
+		for _, q := range queries {
+			if matchPath(q, u.GetPath()) {
+				m[p] = &gpb.Update{
+					Path: &gpb.Path{Origin: q.GetOrigin(), Elem: u.GetPath().GetElem()},
+					Val:  u.GetVal(),
+				}
+				break
+			}
+		}
+	}
+	return m
+}
+
+// matchPath reports whether the path is under the query, which may have wildcards.
+// Queries of origins other than OpenConfig match nothing.
+func matchPath(query, path *gpb.Path) bool {
+	if origin := query.GetOrigin(); origin != "" && origin != "openconfig" {
+		return false
+	}
+	qelems := query.GetElem()
+	if len(qelems) > len(path.GetElem()) {
+		return false
+	}
+	for i, qe := range qelems {
+		pe := path.GetElem()[i]
+		if name := elemName(qe.GetName()); name == "..." {
+			return true
+		} else if name != "*" && name != elemName(pe.GetName()) {
+			return false
+		}
+		for k, v := range qe.GetKey() {
+			if v != "*" && pe.GetKey()[k] != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// elemName returns the name of a path element without its module prefix.
+func elemName(name string) string {
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// joinPath returns the path under the prefix.
+func joinPath(prefix, path *gpb.Path) *gpb.Path {
+	origin := path.GetOrigin()
+	if origin == "" {
+		origin = prefix.GetOrigin()
+	}
+	elems := append(append([]*gpb.PathElem(nil), prefix.GetElem()...), path.GetElem()...)
+	return &gpb.Path{Origin: origin, Elem: elems}
+}
+
+func pathString(p *gpb.Path) string {
+	s, err := ygot.PathToString(p)
+	if err != nil {
+		return p.String()
+	}
+	return s
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// gnmiServer serves gNMI from the tree of a DUT.
+type gnmiServer struct {
+	gpb.UnimplementedGNMIServer
+	tree *tree
+	rpcs *rpcs
+}
+
+const (
+	getMethod = "/gnmi.gNMI/Get"
+	setMethod = "/gnmi.gNMI/Set"
+)
+
+func (s *gnmiServer) Capabilities(context.Context, *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+	return &gpb.CapabilityResponse{
+		SupportedEncodings: []gpb.Encoding{gpb.Encoding_JSON_IETF, gpb.Encoding_PROTO},
+		GNMIVersion:        "0.10.0",
+	}, nil
+}
+
+func (s *gnmiServer) Get(_ context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+	if resp, ok, err := s.rpcs.scripted(getMethod, req); ok {
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*gpb.GetResponse), nil
+	}
+	l, _ := s.tree.snapshot()
+	resp := &gpb.GetResponse{}
+	for _, p := range req.GetPath() {
+		query := joinPath(req.GetPrefix(), p)
+		updates := l.match([]*gpb.Path{query}, req.GetType())
+		if len(updates) == 0 && len(query.GetElem()) > 0 {
+			return nil, status.Errorf(codes.NotFound, "no data at path %s", pathString(query))
+		}
+		n := &gpb.Notification{Timestamp: time.Now().UnixNano()}
+		for _, k := range sortedKeys(updates) {
+			n.Update = append(n.Update, updates[k])
+		}
+		resp.Notification = append(resp.Notification, n)
+	}
+	return resp, nil
+}
+
+func (s *gnmiServer) Set(_ context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+	if resp, ok, err := s.rpcs.scripted(setMethod, req); ok {
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*gpb.SetResponse), nil
+	}
+	schema, err := oc.Schema()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot load the schema: %v", err)
+	}
+	resp := &gpb.SetResponse{Timestamp: time.Now().UnixNano()}
+	result := func(p *gpb.Path, op gpb.UpdateResult_Operation) {
+		resp.Response = append(resp.Response, &gpb.UpdateResult{Path: p, Op: op})
+	}
+	// Only OpenConfig paths are applied to the tree; others, such as CLI config, are
+	// accepted and only recorded.
+	isOC := func(p *gpb.Path) bool {
+		return p.GetOrigin() == "" || p.GetOrigin() == "openconfig"
+	}
+	setNode := func(root *oc.Root, p *gpb.Path, val *gpb.TypedValue) error {
+		if err := ytypes.SetNode(schema.RootSchema(), root, p, val, &ytypes.InitMissingElements{}, &ytypes.PreferShadowPath{}); err != nil {
+			return status.Errorf(codes.InvalidArgument, "cannot set %s: %v", pathString(p), status.Convert(err).Message())
+		}
+		return nil
+	}
+	deleteNode := func(root *oc.Root, p *gpb.Path) error {
+		if err := ytypes.DeleteNode(schema.RootSchema(), root, p, &ytypes.PreferShadowPath{}); err != nil {
+			return status.Errorf(codes.InvalidArgument, "cannot delete %s: %v", pathString(p), status.Convert(err).Message())
+		}
+		return nil
+	}
+	err = s.tree.update(func(root *oc.Root) error {
+		for _, d := range req.GetDelete() {
+			p := joinPath(req.GetPrefix(), d)
+			if isOC(p) {
+				if err := deleteNode(root, p); err != nil {
+					return err
+				}
+			}
+			result(d, gpb.UpdateResult_DELETE)
+		}
+		for _, u := range append(append([]*gpb.Update(nil), req.GetReplace()...), req.GetUnionReplace()...) {
+			p := joinPath(req.GetPrefix(), u.GetPath())
+			if isOC(p) {
+				if err := deleteNode(root, p); err != nil {
+					return err
+				}
+				if err := setNode(root, p, u.GetVal()); err != nil {
+					return err
+				}
+			}
+			result(u.GetPath(), gpb.UpdateResult_REPLACE)
+		}
+		for _, u := range req.GetUpdate() {
+			p := joinPath(req.GetPrefix(), u.GetPath())
+			if isOC(p) {
+				if err := setNode(root, p, u.GetVal()); err != nil {
+					return err
+				}
+			}
+			result(u.GetPath(), gpb.UpdateResult_UPDATE)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// subscription tracks the leaves sent to a subscriber, to send only the changes.
+type subscription struct {
+	stream  gpb.GNMI_SubscribeServer
+	queries []*gpb.Path
+	sent    map[string]*gpb.Update
+}
+
+// send sends the leaves that changed since the last send, and a sync response if
+// sync is set.
+func (s *subscription) send(l *leaves, sync bool) error {
+	current := l.match(s.queries, gpb.GetRequest_ALL)
+	n := &gpb.Notification{Timestamp: time.Now().UnixNano()}
+	for _, k := range sortedKeys(current) {
+		if prev, ok := s.sent[k]; !ok || !proto.Equal(prev.GetVal(), current[k].GetVal()) {
+			n.Update = append(n.Update, current[k])
+		}
+	}
+	for _, k := range sortedKeys(s.sent) {
+		if _, ok := current[k]; !ok {
+			n.Delete = append(n.Delete, s.sent[k].GetPath())
+		}
+	}
+	s.sent = current
+	if len(n.Update) > 0 || len(n.Delete) > 0 {
+		if err := s.stream.Send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: n}}); err != nil {
+			return err
+		}
+	}
+	if sync {
+		return s.stream.Send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_SyncResponse{SyncResponse: true}})
+	}
+	return nil
+}
+
+func (s *gnmiServer) Subscribe(stream gpb.GNMI_SubscribeServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	list := req.GetSubscribe()
+	if list == nil {
+		return status.Error(codes.InvalidArgument, "the first request must be a subscription list")
+	}
+	sub := &subscription{stream: stream}
+	for _, s := range list.GetSubscription() {
+		sub.queries = append(sub.queries, joinPath(list.GetPrefix(), s.GetPath()))
+	}
+
+	l, changed := s.tree.snapshot()
+	if err := sub.send(l, true); err != nil {
+		return err
+	}
+	switch list.GetMode() {
+	case gpb.SubscriptionList_ONCE:
+		return nil
+	case gpb.SubscriptionList_POLL:
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if req.GetPoll() == nil {
+				return status.Error(codes.InvalidArgument, "only polls can follow the subscription list")
+			}
+			// Every poll sends all the leaves again.
+			sub.sent = nil
+			l, _ := s.tree.snapshot()
+			if err := sub.send(l, true); err != nil {
+				return err
+			}
+		}
+	default:
+		for {
+			select {
+			case <-stream.Context().Done():
+				return status.FromContextError(stream.Context().Err()).Err()
+			case <-changed:
+			}
+			l, changed = s.tree.snapshot()
+			if err := sub.send(l, false); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakedut
+
+import (
+	"io"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Handler scripts the responses to the requests of a method.  It is called with each
+// request received, and returns the responses to send back, or the error to end the
+// call with.
+type Handler func(req proto.Message) ([]proto.Message, error)
+
+// rpcs holds the scripted handlers and the requests received by the methods of a DUT.
+type rpcs struct {
+	mu       sync.Mutex
+	handlers map[string]Handler
+	requests map[string][]proto.Message
+}
+
+func newRPCs() *rpcs {
+	return &rpcs{
+		handlers: make(map[string]Handler),
+		requests: make(map[string][]proto.Message),
+	}
+}
+
+func (r *rpcs) handle(method string, h Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[method] = h
+}
+
+func (r *rpcs) received(method string) []proto.Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]proto.Message(nil), r.requests[method]...)
+}
+
+// call records a request and returns the responses of the handler of the method, if
+// there is one.
+func (r *rpcs) call(method string, req proto.Message) (resps []proto.Message, handled bool, err error) {
+	r.mu.Lock()
+	r.requests[method] = append(r.requests[method], proto.Clone(req))
+	h := r.handlers[method]
+	r.mu.Unlock()
+	if h == nil {
+		return nil, false, nil
+	}
+	resps, err = h(req)
+	return resps, true, err
+}
+
+// scripted records the request of a unary method, and returns the response of the
+// handler of the method if there is one.
+func (r *rpcs) scripted(method string, req proto.Message) (resp proto.Message, handled bool, err error) {
+	resps, handled, err := r.call(method, req)
+	if !handled || err != nil {
+		return nil, handled, err
+	}
+	if len(resps) > 1 {
+		return nil, true, status.Errorf(codes.Internal, "handler of unary method %s returned %d responses", method, len(resps))
+	}
+	if len(resps) == 0 {
+		return nil, false, nil
+	}
+	return resps[0], true, nil
+}
+
+// serve serves every method that has no server registered, decoding the messages
+// with the types registered for its service.  Without a handler, a method responds
+// with an empty message if it is unary, or else with no messages.
+func (r *rpcs) serve(_ any, stream grpc.ServerStream) error {
+	method, _ := grpc.MethodFromServerStream(stream)
+	md, err := methodDescriptor(method)
+	if err != nil {
+		return err
+	}
+	var last proto.Message
+	for {
+		req := newMessage(md.Input())
+		if err := stream.RecvMsg(req); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		resps, _, err := r.call(method, req)
+		if err != nil {
+			return err
+		}
+		if md.IsStreamingServer() {
+			for _, resp := range resps {
+				if err := stream.SendMsg(resp); err != nil {
+					return err
+				}
+			}
+		} else if len(resps) > 1 {
+			return status.Errorf(codes.Internal, "handler of method %s returned %d responses, want at most 1", method, len(resps))
+		} else if len(resps) == 1 {
+			last = resps[0]
+		}
+		if !md.IsStreamingClient() {
+			break
+		}
+	}
+	if md.IsStreamingServer() {
+		return nil
+	}
+	if last == nil {
+		last = newMessage(md.Output())
+	}
+	return stream.SendMsg(last)
+}
+
+// methodDescriptor returns the descriptor of a method given by its full gRPC name.
+func methodDescriptor(method string) (protoreflect.MethodDescriptor, error) {
+	svc, name, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "malformed method name %q", method)
+	}
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(svc))
+	if err != nil {
+		return nil, status.Errorf(codes.Unimplemented, "unknown service %s", svc)
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%s is not a service", svc)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(name))
+	if md == nil {
+		return nil, status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+	return md, nil
+}
+
+// newMessage returns an empty message of the type, preferring the Go type if it is
+// registered.
+func newMessage(md protoreflect.MessageDescriptor) proto.Message {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName()); err == nil {
+		return mt.New().Interface()
+	}
+	return dynamicpb.NewMessage(md)
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const jumpPortDefault = "22"
+
+var (
+	// jumpClients are the connections to the jump hosts, keyed by user and address,
+	// so that the SSH sessions and gRPC connections through a jump host share one
+	// connection to it.
+	jumpMu      sync.Mutex
+	jumpClients = make(map[string]*ssh.Client)
+)
+
+// jumpAddr returns the address of the jump host of the options, with the default
+// port if it has none.
+func jumpAddr(bopts *bindpb.Options) string {
+	host := bopts.GetJumpHost()
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		return net.JoinHostPort(host, jumpPortDefault)
+	}
+	return host
+}
+
+// jumpConfig returns the client config to connect to the jump host of the options.
+func jumpConfig(bopts *bindpb.Options) (*ssh.ClientConfig, error) {
+	if bopts.GetJumpUsername() == "" {
+		return nil, errors.New("jump_username must be set with jump_host")
+	}
+	config := &ssh.ClientConfig{User: bopts.GetJumpUsername()}
+	if file := bopts.GetJumpKeyFile(); file != "" {
+		key, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("jump_key_file: %w", err)
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse jump host private key: %w", err)
+		}
+		config.Auth = append(config.Auth, ssh.PublicKeys(signer))
+	}
+	if password := bopts.GetJumpPassword(); password != "" {
+		config.Auth = append(config.Auth,
+			ssh.Password(password),
+			ssh.KeyboardInteractive(sshInteractive(password)),
+		)
+	}
+	if len(config.Auth) == 0 {
+		return nil, errors.New("jump_key_file or jump_password must be set with jump_host")
+	}
+	var err error
+	if file := bopts.GetJumpKnownHostsFile(); file != "" {
+		config.HostKeyCallback, err = knownhosts.New(file)
+	} else {
+		config.HostKeyCallback, err = knownHostsCallback()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load jump host known_hosts: %w", err)
+	}
+	return config, nil
+}
+
+// jumpClient returns the connection to the jump host of the options, connecting to
+// it if there is none yet.
+func jumpClient(bopts *bindpb.Options) (*ssh.Client, error) {
+	addr := jumpAddr(bopts)
+	key := bopts.GetJumpUsername() + "@" + addr
+	jumpMu.Lock()
+	defer jumpMu.Unlock()
+	if jc, ok := jumpClients[key]; ok {
+		return jc, nil
+	}
+	config, err := jumpConfig(bopts)
+	if err != nil {
+		return nil, err
+	}
+	jc, err := ssh.Dial("tcp", addr, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to jump host %s: %w", addr, err)
+	}
+	jumpClients[key] = jc
+	// Forget the connection once it is lost, so that the next dial reconnects.
+	go func() {
+		jc.Wait()
+		jumpMu.Lock()
+		defer jumpMu.Unlock()
+		if jumpClients[key] == jc {
+			delete(jumpClients, key)
+		}
+	}()
+	return jc, nil
+}
+
+// closeJumpClients closes the connections to the jump hosts.
+func closeJumpClients() {
+	jumpMu.Lock()
+	defer jumpMu.Unlock()
+	for key, jc := range jumpClients {
+		jc.Close()
+		delete(jumpClients, key)
+	}
+}
+
+// jumpDial connects to addr through the jump host of the options.  The address is
+// resolved by the jump host.
+func jumpDial(ctx context.Context, bopts *bindpb.Options, addr string) (net.Conn, error) {
+	jc, err := jumpClient(bopts)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := jc.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach %s through jump host %s: %w", addr, jumpAddr(bopts), err)
+	}
+	return conn, nil
+}
+
+// jumpSSH connects an SSH client to the target of the options through their jump
+// host.
+func jumpSSH(config *ssh.ClientConfig, bopts *bindpb.Options) (*ssh.Client, error) {
+	conn, err := jumpDial(context.Background(), bopts, bopts.Target)
+	if err != nil {
+		return nil, err
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, bopts.Target, config)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return ssh.NewClient(c, chans, reqs), nil
+}
@@ -0,0 +1,277 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ondatra/binding"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"google.golang.org/grpc"
+)
+
+// jumpHostFixture is an SSH server that forwards the connections of its clients,
+// like a jump host.  It accepts the user "jump" with the password "hop".
+type jumpHostFixture struct {
+	addr string
+	// knownHosts is a known_hosts file with the key of the jump host.
+	knownHosts string
+
+	mu        sync.Mutex
+	forwarded []string
+}
+
+func startJumpHost(t *testing.T) *jumpHostFixture {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Could not listen: %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	f := &jumpHostFixture{
+		addr:       l.Addr().String(),
+		knownHosts: filepath.Join(t.TempDir(), "known_hosts"),
+	}
+	line := knownhosts.Line([]string{knownhosts.Normalize(f.addr)}, sshServerSigner.PublicKey())
+	if err := os.WriteFile(f.knownHosts, []byte(line+"\n"), 0600); err != nil {
+		t.Fatalf("Could not write known_hosts: %v", err)
+	}
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if conn.User() == "jump" && string(password) == "hop" {
+				return nil, nil
+			}
+			return nil, errors.New("login error")
+		},
+	}
+	config.AddHostKey(sshServerSigner)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn, config)
+		}
+	}()
+	t.Cleanup(closeJumpClients)
+	return f
+}
+
+func (f *jumpHostFixture) serve(conn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "direct-tcpip" {
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		var msg struct {
+			Host     string
+			Port     uint32
+			OrigHost string
+			OrigPort uint32
+		}
+		if err := ssh.Unmarshal(newChannel.ExtraData(), &msg); err != nil {
+			newChannel.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+		addr := net.JoinHostPort(msg.Host, fmt.Sprint(msg.Port))
+		target, err := net.Dial("tcp", addr)
+		if err != nil {
+			newChannel.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+		channel, chReqs, err := newChannel.Accept()
+		if err != nil {
+			target.Close()
+			continue
+		}
+		f.mu.Lock()
+		f.forwarded = append(f.forwarded, addr)
+		f.mu.Unlock()
+		go ssh.DiscardRequests(chReqs)
+		go func() {
+			defer channel.Close()
+			io.Copy(channel, target)
+		}()
+		go func() {
+			defer target.Close()
+			io.Copy(target, channel)
+		}()
+	}
+}
+
+func (f *jumpHostFixture) forwardedTo() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.forwarded...)
+}
+
+func (f *jumpHostFixture) options() *bindpb.Options {
+	return &bindpb.Options{
+		JumpHost:           f.addr,
+		JumpUsername:       "jump",
+		JumpPassword:       "hop",
+		JumpKnownHostsFile: f.knownHosts,
+	}
+}
+
+func TestJumpGRPC(t *testing.T) {
+	jump := startJumpHost(t)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Could not listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	gpb.RegisterGNMIServer(srv, &gpb.UnimplementedGNMIServer{})
+	go srv.Serve(l)
+	defer srv.Stop()
+
+	origDial := grpcDialContextFn
+	grpcDialContextFn = grpc.NewClient
+	defer func() { grpcDialContextFn = origDial }()
+
+	bopts := jump.options()
+	bopts.Target = l.Addr().String()
+	bopts.Insecure = true
+	dialer, err := makeDialer(&svcParams{}, bopts)
+	if err != nil {
+		t.Fatalf("makeDialer() got err: %v", err)
+	}
+	conn, err := dialer.Dial(context.Background())
+	if err != nil {
+		t.Fatalf("Dial() got err: %v", err)
+	}
+	defer conn.Close()
+	// The server is reached if the call fails as unimplemented rather than unavailable.
+	_, err = gpb.NewGNMIClient(conn).Capabilities(context.Background(), &gpb.CapabilityRequest{})
+	if err == nil || !strings.Contains(err.Error(), "Unimplemented") {
+		t.Errorf("Capabilities() got err %v, want Unimplemented", err)
+	}
+	if got := jump.forwardedTo(); len(got) == 0 || got[0] != bopts.Target {
+		t.Errorf("Jump host forwarded to %v, want %s", got, bopts.Target)
+	}
+}
+
+func TestJumpSSH(t *testing.T) {
+	jump := startJumpHost(t)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Could not listen: %v", err)
+	}
+	defer l.Close()
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		config := &ssh.ServerConfig{
+			PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+				if conn.User() == "alice" && string(password) == "bob" {
+					return nil, nil
+				}
+				return nil, errors.New("login error")
+			},
+		}
+		config.AddHostKey(sshServerSigner)
+		_, chans, reqs, err := ssh.NewServerConn(conn, config)
+		if err != nil {
+			return
+		}
+		go ssh.DiscardRequests(reqs)
+		(&sshClientFixture{}).handleServerNewChannel(chans)
+	}()
+
+	d := &staticDUT{
+		r:   resolver{&bindpb.Binding{Options: jump.options()}},
+		dev: &bindpb.Device{Ssh: &bindpb.Options{Target: l.Addr().String(), SkipVerify: true}},
+	}
+	c, err := d.DialSSH(context.Background(), binding.PasswordAuth{User: "alice", Password: "bob"})
+	if err != nil {
+		t.Fatalf("DialSSH() got err: %v", err)
+	}
+	defer c.Close()
+	res, err := c.RunCommand(context.Background(), "xyzzy")
+	if err != nil {
+		t.Fatalf("RunCommand() got err: %v", err)
+	}
+	if got, want := res.Output(), "exec command: xyzzy"; !strings.Contains(got, want) {
+		t.Errorf("RunCommand() got output %q, want it to contain %q", got, want)
+	}
+	if got := jump.forwardedTo(); len(got) != 1 || got[0] != l.Addr().String() {
+		t.Errorf("Jump host forwarded to %v, want [%s]", got, l.Addr())
+	}
+}
+
+func TestJumpConfig_Error(t *testing.T) {
+	tests := []struct {
+		desc    string
+		bopts   *bindpb.Options
+		wantErr string
+	}{{
+		desc:    "no username",
+		bopts:   &bindpb.Options{JumpHost: "bastion", JumpPassword: "hop"},
+		wantErr: "jump_username",
+	}, {
+		desc:    "no auth",
+		bopts:   &bindpb.Options{JumpHost: "bastion", JumpUsername: "jump"},
+		wantErr: "jump_key_file or jump_password",
+	}, {
+		desc:    "missing key file",
+		bopts:   &bindpb.Options{JumpHost: "bastion", JumpUsername: "jump", JumpKeyFile: "/nonexistent/key"},
+		wantErr: "jump_key_file",
+	}, {
+		desc:    "missing known_hosts file",
+		bopts:   &bindpb.Options{JumpHost: "bastion", JumpUsername: "jump", JumpPassword: "hop", JumpKnownHostsFile: "/nonexistent/known_hosts"},
+		wantErr: "known_hosts",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := jumpConfig(tt.bopts)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("jumpConfig() got err %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJumpAddr(t *testing.T) {
+	for host, want := range map[string]string{
+		"bastion":         "bastion:22",
+		"bastion:2222":    "bastion:2222",
+		"192.0.2.1":       "192.0.2.1:22",
+		"[2001:db8::1]:1": "[2001:db8::1]:1",
+	} {
+		if got := jumpAddr(&bindpb.Options{JumpHost: host}); got != want {
+			t.Errorf("jumpAddr(%q) got %q, want %q", host, got, want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/binding/introspect"
+	"google.golang.org/protobuf/proto"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// LintFiles reads a testbed file and a binding file and lints them with Lint.
+func LintFiles(ctx context.Context, testbedFile, bindingFile string) (errs, warnings []error) {
+	tb := &opb.Testbed{}
+	if err := readText(testbedFile, tb); err != nil {
+		return []error{fmt.Errorf("unable to read testbed file: %w", err)}, nil
+	}
+	b := &bindpb.Binding{}
+	if err := readText(bindingFile, b); err != nil {
+		return []error{fmt.Errorf("unable to read binding file: %w", err)}, nil
+	}
+	return Lint(ctx, tb, b)
+}
+
+// Lint checks offline whether a binding satisfies a testbed.  It runs the same
+// reservation logic as Reserve, but does not dial or reset any device.  Problems
+// that would fail the reservation or the dialing of a device are returned as errors.
+// Other problems are returned as warnings: binding ports and devices that the testbed
+// does not use, and options that cannot be resolved here because they refer to
+// environment variables or files of the test host.
+func Lint(ctx context.Context, tb *opb.Testbed, b *bindpb.Binding) (errs, warnings []error) {
+	if err := checkBinding(b); err != nil {
+		errs = append(errs, err)
+	}
+	resolved := proto.Clone(b).(*bindpb.Binding)
+	if err := resolveBinding(resolved); err != nil {
+		warnings = append(warnings, fmt.Errorf("unable to resolve binding options: %w", err))
+		resolved = b
+	}
+	r := resolver{resolved}
+
+	var resv *binding.Reservation
+	if r.Dynamic {
+		var err error
+		if resv, err = dynamicReservation(ctx, tb, r); err != nil {
+			return append(errs, err), warnings
+		}
+	} else {
+		var resvErrs []error
+		resv, resvErrs = staticReservation(tb, r)
+		errs = append(errs, resvErrs...)
+		warnings = append(warnings, compareDevices(tb, b).unused...)
+	}
+	errs = append(errs, missingTargets(r, resv)...)
+	return errs, warnings
+}
+
+// missingTargets returns an error for every service of a reserved device that has no
+// dial target, because neither the device name nor the target option is set.
+func missingTargets(r resolver, resv *binding.Reservation) []error {
+	var errs []error
+	missing := func(kind, id, svc string, bopts *bindpb.Options) {
+		// Without a device name, the default target is only the port.
+		if target := bopts.GetTarget(); target == "" || strings.HasPrefix(target, ":") {
+			errs = append(errs, fmt.Errorf("%s %q has no name or %s target", kind, id, svc))
+		}
+	}
+	for _, id := range sortedKeys(resv.DUTs) {
+		d, ok := resv.DUTs[id].(*staticDUT)
+		if !ok {
+			continue
+		}
+		for _, svc := range sortedServices(dutSvcParams) {
+			missing("DUT", id, string(svc), r.grpc(d.dev, dutSvcParams[svc]))
+		}
+		missing("DUT", id, "SSH", r.ssh(d.dev))
+	}
+	for _, id := range sortedKeys(resv.ATEs) {
+		a, ok := resv.ATEs[id].(*staticATE)
+		if !ok {
+			continue
+		}
+		if a.dev.GetOtg() == nil && a.dev.GetIxnetwork() != nil {
+			missing("ATE", id, "IxNetwork", r.ixnetwork(a.dev))
+			continue
+		}
+		for _, svc := range sortedServices(ateSvcParams) {
+			missing("ATE", id, string(svc), r.grpc(a.dev, ateSvcParams[svc]))
+		}
+	}
+	return errs
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedServices(m map[introspect.Service]*svcParams) []introspect.Service {
+	var svcs []introspect.Service
+	for svc := range m {
+		svcs = append(svcs, svc)
+	}
+	sort.Slice(svcs, func(i, j int) bool { return svcs[i] < svcs[j] })
+	return svcs
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func errStrings(errs []error) []string {
+	var strs []string
+	for _, err := range errs {
+		strs = append(strs, err.Error())
+	}
+	return strs
+}
+
+func TestLint(t *testing.T) {
+	tb := &opb.Testbed{
+		Duts: []*opb.Device{{
+			Id: "dut",
+			Ports: []*opb.Port{{
+				Id: "port1",
+			}, {
+				Id:    "port2",
+				Speed: opb.Port_S_100GB,
+			}},
+		}},
+		Ates: []*opb.Device{{
+			Id:    "ate",
+			Ports: []*opb.Port{{Id: "port1"}},
+		}},
+	}
+	b := &bindpb.Binding{
+		Options: &bindpb.Options{
+			PasswordFile: "${LINT_TEST_UNSET}/password",
+		},
+		Duts: []*bindpb.Device{{
+			Id: "dut",
+			Gnmi: &bindpb.Options{
+				Target: "dut.gnmi:9339",
+			},
+			Ports: []*bindpb.Port{{
+				Id:   "port1",
+				Name: "Ethernet1",
+			}, {
+				Id:    "port2",
+				Name:  "Ethernet2",
+				Speed: opb.Port_S_400GB,
+			}, {
+				Id:   "port3",
+				Name: "Ethernet3",
+			}},
+		}, {
+			Id:   "dut2",
+			Name: "dut2.name",
+		}},
+		Ates: []*bindpb.Device{{
+			Id:   "ate",
+			Name: "ate.name",
+			Ports: []*bindpb.Port{{
+				Id:   "port1",
+				Name: "1/1",
+			}},
+		}},
+	}
+
+	errs, warnings := Lint(context.Background(), tb, b)
+
+	// The gNMI target of the DUT is set explicitly.
+	wantErrs := []string{
+		"binding port speed S_400GB and testbed port speed S_100GB do not match",
+		`DUT "dut" has no name or P4RT target`,
+		`DUT "dut" has no name or gNOI target`,
+		`DUT "dut" has no name or gNPSI target`,
+		`DUT "dut" has no name or gNSI target`,
+		`DUT "dut" has no name or gRIBI target`,
+		`DUT "dut" has no name or SSH target`,
+	}
+	if diff := cmp.Diff(wantErrs, errStrings(errs)); diff != "" {
+		t.Errorf("Lint() got unexpected errors (-want,+got):\n%s", diff)
+	}
+	wantWarnings := []string{
+		"unable to resolve binding options: options: password_file: environment variable LINT_TEST_UNSET is not set",
+		`port "port3" (Ethernet3) on "dut" is not used by the testbed`,
+		`DUT "dut2" is not used by the testbed`,
+	}
+	if diff := cmp.Diff(wantWarnings, errStrings(warnings)); diff != "" {
+		t.Errorf("Lint() got unexpected warnings (-want,+got):\n%s", diff)
+	}
+}
+
+func TestLintFiles(t *testing.T) {
+	errs, warnings := LintFiles(context.Background(), "../atedut_2.testbed", "../atedut_2.binding")
+	if len(errs) > 0 {
+		t.Errorf("LintFiles() got errors: %v", errs)
+	}
+	if len(warnings) > 0 {
+		t.Errorf("LintFiles() got warnings: %v", warnings)
+	}
+}
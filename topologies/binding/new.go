@@ -20,15 +20,19 @@ import (
 	"fmt"
 	"os"
 	"plugin"
+	"sync"
 	"time"
 
 	"flag"
 
 	"github.com/golang/glog"
 	"github.com/openconfig/featureprofiles/internal/core"
+	"github.com/openconfig/featureprofiles/internal/deviations"
 	"github.com/openconfig/featureprofiles/internal/rundata"
+	"github.com/openconfig/featureprofiles/topologies/binding/fakedut"
 	"github.com/openconfig/ondatra"
 	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/eventlis"
 	"github.com/openconfig/ondatra/knebind"
 	knecreds "github.com/openconfig/ondatra/knebind/creds"
 	"google.golang.org/protobuf/encoding/prototext"
@@ -45,12 +49,15 @@ var (
 	pushConfig   = flag.Bool("push-config", true, "push device reset config supplied to static binding")
 	kneTopo      = flag.String("kne-topo", "", "KNE topology file")
 	kneSkipReset = flag.Bool("kne-skip-reset", false, "skip the initial config reset phase when using KNE")
+	fakeDUTs     = flag.Bool("fake-duts", false, "reserve in-process fake DUTs instead of devices")
+	resetWorkers = flag.Int("reset-workers", 8, "maximum number of devices reset concurrently by the static binding")
+	runRecord    = flag.Bool("run-record", true, "write the run record to "+rundata.RecordJSONFile+" and "+rundata.RecordProtoFile+" in -outputs_dir when the testbed is released")
 	credFlags    = knecreds.DefineFlags()
 )
 
 // New creates a new binding that could be either a vendor plugin, a
-// binding configuration file, or a KNE configuration file.  This
-// depends on the command line flags given.
+// binding configuration file, a KNE configuration file, or fake DUTs.
+// This depends on the command line flags given.
 //
 // The vendor plugin should be a "package main" with a New function
 // that will receive the value of the --plugin-args flag as a string.
@@ -75,10 +82,15 @@ func New() (binding.Binding, error) {
 	}
 	// Register core file handler for DUTs.
 	core.Register()
-	return &rundataBind{Binding: b}, nil
+	rb := &rundataBind{Binding: b, props: make(map[string]string)}
+	ondatra.EventListener().AddAfterTestsCallback(rb.afterTests)
+	return rb, nil
 }
 
 func newBind() (binding.Binding, error) {
+	if *fakeDUTs {
+		return &fakedut.Binding{}, nil
+	}
 	if *pluginFile != "" {
 		return loadBinding(*pluginFile, *pluginArgs)
 	}
@@ -138,21 +150,38 @@ func staticBinding(bindingFile string) (binding.Binding, error) {
 	if err := prototext.Unmarshal(in, b); err != nil {
 		return nil, fmt.Errorf("unable to parse binding file: %w", err)
 	}
+	if err := resolveBinding(b); err != nil {
+		return nil, fmt.Errorf("unable to resolve binding options: %w", err)
+	}
+	if err := checkBinding(b); err != nil {
+		return nil, err
+	}
+	return &staticBind{
+		Binding:      nil,
+		r:            resolver{b},
+		pushConfig:   *pushConfig,
+		resetWorkers: *resetWorkers,
+	}, nil
+}
+
+// checkBinding checks the binding for options that cannot be used together.
+func checkBinding(b *bindpb.Binding) error {
 	for _, ate := range b.Ates {
 		if ate.Otg != nil && ate.Ixnetwork != nil {
-			return nil, fmt.Errorf("otg and ixnetwork are mutually exclusive, please configure one of them in ate %s binding", ate.Name)
+			return fmt.Errorf("otg and ixnetwork are mutually exclusive, please configure one of them in ate %s binding", ate.Name)
 		}
 	}
-	return &staticBind{
-		Binding:    nil,
-		r:          resolver{b},
-		pushConfig: *pushConfig,
-	}, nil
+	return nil
 }
 
 // rundataBind wraps an Ondatra binding to report rundata.
 type rundataBind struct {
 	binding.Binding
+
+	mu       sync.Mutex
+	props    map[string]string           // Properties reported, for the run record.
+	duts     map[string]*rundata.DUTInfo // DUTs reserved, for the run record.
+	exitCode *int
 }
 
 func (b *rundataBind) Reserve(ctx context.Context, tb *opb.Testbed, runTime, waitTime time.Duration, partial map[string]string) (*binding.Reservation, error) {
@@ -174,14 +203,60 @@ func (b *rundataBind) FetchReservation(ctx context.Context, id string) (*binding
 }
 
 func (b *rundataBind) addResvProperties(ctx context.Context, resv *binding.Reservation) {
-	for k, v := range rundata.Properties(ctx, resv) {
-		ondatra.Report().AddSuiteProperty(k, v)
+	props, duts := rundata.Collect(ctx, resv)
+	b.addProperties(props)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.duts = make(map[string]*rundata.DUTInfo)
+	for id := range resv.DUTs {
+		// DUTs that could not be queried are still in the record, with only their ID.
+		b.duts[id] = &rundata.DUTInfo{}
+		if di, ok := duts[id]; ok {
+			b.duts[id] = di
+		}
 	}
 }
 
-func (b *rundataBind) Release(ctx context.Context) error {
-	for k, v := range rundata.Timing(ctx) {
+// addProperties reports the properties and keeps them for the run record.
+func (b *rundataBind) addProperties(m map[string]string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for k, v := range m {
 		ondatra.Report().AddSuiteProperty(k, v)
+		b.props[k] = v
 	}
+}
+
+// afterTests keeps the exit code of the tests for the run record.
+func (b *rundataBind) afterTests(e *eventlis.AfterTestsEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.exitCode = e.ExitCode
+	return nil
+}
+
+func (b *rundataBind) Release(ctx context.Context) error {
+	b.addProperties(rundata.Timing(ctx))
+	b.addProperties(deviations.Properties())
+	b.writeRecord()
 	return b.Binding.Release(ctx)
 }
+
+// writeRecord writes the run record to the outputs directory, if any.
+func (b *rundataBind) writeRecord() {
+	if !*runRecord {
+		return
+	}
+	dir := core.OutputsDir()
+	if dir == "" {
+		glog.Warning("Not writing the run record without -outputs_dir")
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	rec := rundata.Record(b.props, b.duts)
+	rec.Outcome = rundata.Outcome(b.exitCode)
+	if err := rundata.WriteRecord(dir, rec); err != nil {
+		glog.Errorf("Could not write the run record: %v", err)
+	}
+}
@@ -17,10 +17,14 @@ package binding
 import (
 	"flag"
 	"fmt"
+	"os"
+	"regexp"
+	"strings"
 
 	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
 	"github.com/openconfig/ondatra/binding/introspect"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // IANA assigns 9339 for gNxI, 9559 for P4RT and 9340 for gRIBI.
@@ -89,6 +93,111 @@ func merge(bopts ...*bindpb.Options) *bindpb.Options {
 	return result
 }
 
+var envRef = regexp.MustCompile(`\$\{(\w+)\}`)
+
+// expandEnv replaces the ${NAME} references in s with the values of the environment
+// variables.  Unlike os.ExpandEnv, it leaves other uses of $ alone, since they may be
+// part of a password, and it fails if a variable is not set.
+func expandEnv(s string) (string, error) {
+	var err error
+	expanded := envRef.ReplaceAllStringFunc(s, func(ref string) string {
+		name := envRef.FindStringSubmatch(ref)[1]
+		val, ok := os.LookupEnv(name)
+		if !ok && err == nil {
+			err = fmt.Errorf("environment variable %s is not set", name)
+		}
+		return val
+	})
+	return expanded, err
+}
+
+// readSecret returns the content of a username or password file, without the
+// trailing whitespace.
+func readSecret(file string) (string, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(b), " \t\r\n"), nil
+}
+
+// resolveOptions expands the environment variables in the string fields of the
+// options, and reads the username and password from their files.  Each set of
+// options is resolved on its own before they are merged, so that a username or
+// password given at a more specific level overrides one read from a file at a less
+// specific level, and vice versa.
+func resolveOptions(bopts *bindpb.Options) error {
+	if bopts == nil {
+		return nil
+	}
+	var err error
+	m := bopts.ProtoReflect()
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.StringKind {
+			return true
+		}
+		var s string
+		if s, err = expandEnv(v.String()); err != nil {
+			err = fmt.Errorf("%s: %w", fd.Name(), err)
+			return false
+		}
+		m.Set(fd, protoreflect.ValueOfString(s))
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, secret := range []struct {
+		name      string
+		file      string
+		val       *string
+		fileField string
+	}{
+		{"username", bopts.GetUsernameFile(), &bopts.Username, "username_file"},
+		{"password", bopts.GetPasswordFile(), &bopts.Password, "password_file"},
+	} {
+		if secret.file == "" {
+			continue
+		}
+		if *secret.val != "" {
+			return fmt.Errorf("%s and %s are mutually exclusive", secret.name, secret.fileField)
+		}
+		if *secret.val, err = readSecret(secret.file); err != nil {
+			return fmt.Errorf("%s: %w", secret.fileField, err)
+		}
+	}
+	return nil
+}
+
+// resolveBinding resolves every set of options in the binding.
+func resolveBinding(b *bindpb.Binding) error {
+	if err := resolveOptions(b.GetOptions()); err != nil {
+		return fmt.Errorf("options: %w", err)
+	}
+	for _, dev := range append(append([]*bindpb.Device{}, b.GetDuts()...), b.GetAtes()...) {
+		var err error
+		m := dev.ProtoReflect()
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			if fd.Kind() != protoreflect.MessageKind || fd.IsList() {
+				return true
+			}
+			bopts, ok := v.Message().Interface().(*bindpb.Options)
+			if !ok {
+				return true
+			}
+			if err = resolveOptions(bopts); err != nil {
+				err = fmt.Errorf("device %q %s options: %w", dev.GetName(), fd.Name(), err)
+				return false
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type resolver struct {
 	*bindpb.Binding
 }
@@ -15,7 +15,10 @@
 package binding
 
 import (
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -272,3 +275,113 @@ func TestResolver_Options(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveBinding(t *testing.T) {
+	dir := t.TempDir()
+	writeSecret := func(name, content string) string {
+		t.Helper()
+		file := filepath.Join(dir, name)
+		if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+			t.Fatalf("Cannot write %s: %v", file, err)
+		}
+		return file
+	}
+	t.Setenv("BINDING_TEST_DIR", dir)
+	t.Setenv("BINDING_TEST_USER", "env.username")
+	writeSecret("global.password", "global.password\n")
+	gnmiPassword := writeSecret("gnmi.password", "gnmi.password")
+
+	b := &bindpb.Binding{
+		Options: &bindpb.Options{
+			Username:     "${BINDING_TEST_USER}",
+			PasswordFile: "${BINDING_TEST_DIR}/global.password",
+		},
+		Duts: []*bindpb.Device{{
+			Id:   "dut",
+			Name: "dut.name",
+			Options: &bindpb.Options{
+				CertFile: "${BINDING_TEST_DIR}/cert.pem",
+			},
+			Ssh: &bindpb.Options{
+				Password: "pa$$word",
+			},
+			Gnmi: &bindpb.Options{
+				PasswordFile: gnmiPassword,
+			},
+		}},
+	}
+	if err := resolveBinding(b); err != nil {
+		t.Fatalf("resolveBinding() got error: %v", err)
+	}
+	r := resolver{b}
+	dut := b.Duts[0]
+
+	cases := []struct {
+		test string
+		got  *bindpb.Options
+		want *bindpb.Options
+	}{{
+		test: "ssh",
+		got:  r.ssh(dut),
+		want: &bindpb.Options{
+			Target:       "dut.name",
+			Username:     "env.username",
+			Password:     "pa$$word",
+			PasswordFile: filepath.Join(dir, "global.password"),
+			CertFile:     filepath.Join(dir, "cert.pem"),
+		},
+	}, {
+		test: "gnmi",
+		got:  r.grpc(dut, dutSvcParams[introspect.GNMI]),
+		want: &bindpb.Options{
+			Target:       "dut.name:" + strconv.Itoa(*gnmiPort),
+			Username:     "env.username",
+			Password:     "gnmi.password",
+			PasswordFile: gnmiPassword,
+			CertFile:     filepath.Join(dir, "cert.pem"),
+		},
+	}}
+	for _, c := range cases {
+		t.Run(c.test, func(t *testing.T) {
+			if diff := cmp.Diff(c.want, c.got, protocmp.Transform()); diff != "" {
+				t.Errorf("Resolve diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveBinding_Error(t *testing.T) {
+	cases := []struct {
+		name    string
+		b       *bindpb.Binding
+		wantErr string
+	}{{
+		name: "unset variable",
+		b: &bindpb.Binding{Options: &bindpb.Options{
+			Password: "${BINDING_TEST_UNSET}",
+		}},
+		wantErr: "BINDING_TEST_UNSET is not set",
+	}, {
+		name: "missing file",
+		b: &bindpb.Binding{Duts: []*bindpb.Device{{
+			Name: "dut.name",
+			Gnoi: &bindpb.Options{PasswordFile: filepath.Join(t.TempDir(), "missing")},
+		}}},
+		wantErr: `device "dut.name" gnoi options: password_file`,
+	}, {
+		name: "both username and file",
+		b: &bindpb.Binding{Options: &bindpb.Options{
+			Username:     "username",
+			UsernameFile: "username.txt",
+		}},
+		wantErr: "mutually exclusive",
+	}}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := resolveBinding(c.b)
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Errorf("resolveBinding() got error %v, want error containing %q", err, c.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,394 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openconfig/featureprofiles/internal/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	blpb "google.golang.org/grpc/binarylog/grpc_binarylog_v1"
+)
+
+var (
+	grpcRecord           = flag.Bool("grpc_record", false, "Record the gRPC requests and responses sent to and received from the devices to a <target>.grpclog.binpb file per dial target in -outputs_dir, as length-delimited grpc.binarylog.v1.GrpcLogEntry protos.  Credentials are redacted.")
+	grpcRecordMaxBytes   = flag.Int64("grpc_record_max_bytes", 1<<30, "Maximum size of each gRPC record file in bytes; later calls are not recorded.")
+	grpcRecordMaxMsgSize = flag.Int("grpc_record_max_message_bytes", 1<<20, "Maximum size of a recorded gRPC message in bytes; larger messages are truncated.")
+)
+
+var (
+	recordersMu sync.Mutex
+	// recorders holds the recorder of each dial target, so that connections to the
+	// same target share a record file.
+	recorders = map[string]*recorder{}
+	// callID numbers the calls across all recorders.
+	callID atomic.Uint64
+)
+
+// recorderDialOpts returns the dial options that record the calls to a target, or
+// nothing if recording is not enabled.
+func recorderDialOpts(target string) []grpc.DialOption {
+	if !*grpcRecord {
+		return nil
+	}
+	dir := core.OutputsDir()
+	if dir == "" {
+		glog.Warningf("Cannot record gRPC calls to %s without -outputs_dir", target)
+		return nil
+	}
+	recordersMu.Lock()
+	defer recordersMu.Unlock()
+	r, ok := recorders[target]
+	if !ok {
+		r = newRecorder(filepath.Join(dir, recordFilename(target)), *grpcRecordMaxBytes, *grpcRecordMaxMsgSize)
+		recorders[target] = r
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(r.unaryInterceptor(target)),
+		grpc.WithChainStreamInterceptor(r.streamInterceptor(target)),
+	}
+}
+
+var unsafeFilename = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+func recordFilename(target string) string {
+	return unsafeFilename.ReplaceAllString(target, "_") + ".grpclog.binpb"
+}
+
+// recorder writes gRPC log entries to a file, which is created on the first entry.
+type recorder struct {
+	file       string
+	maxBytes   int64
+	maxMsgSize int
+
+	mu      sync.Mutex
+	w       *bufio.Writer
+	f       *os.File
+	written int64
+	// done is set when the recorder stopped writing, after an error or when the file
+	// is full.
+	done bool
+}
+
+func newRecorder(file string, maxBytes int64, maxMsgSize int) *recorder {
+	return &recorder{file: file, maxBytes: maxBytes, maxMsgSize: maxMsgSize}
+}
+
+// write appends an entry to the file.  Entries are flushed as they are written, so
+// that the record is complete even if the test binary is killed.
+func (r *recorder) write(e *blpb.GrpcLogEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.done {
+		return
+	}
+	if r.f == nil {
+		f, err := os.Create(r.file)
+		if err != nil {
+			glog.Warningf("Cannot record gRPC calls: %v", err)
+			r.done = true
+			return
+		}
+		r.f = f
+		r.w = bufio.NewWriter(f)
+		glog.Infof("Recording gRPC calls to %s", r.file)
+	}
+	size := proto.Size(e)
+	if r.written+int64(protowire.SizeVarint(uint64(size))+size) > r.maxBytes {
+		glog.Warningf("Stopped recording gRPC calls to %s after %d bytes", r.file, r.written)
+		r.stop()
+		return
+	}
+	n, err := protodelim.MarshalTo(r.w, e)
+	r.written += int64(n)
+	if err == nil {
+		err = r.w.Flush()
+	}
+	if err != nil {
+		glog.Warningf("Stopped recording gRPC calls to %s: %v", r.file, err)
+		r.stop()
+	}
+}
+
+// stop closes the file; it must be called with the mutex held.
+func (r *recorder) stop() {
+	r.done = true
+	if err := r.f.Close(); err != nil {
+		glog.Warningf("Cannot close %s: %v", r.file, err)
+	}
+}
+
+// call records the events of one call.
+type call struct {
+	r      *recorder
+	id     uint64
+	seq    atomic.Uint64
+	logged atomic.Bool
+}
+
+func (r *recorder) newCall() *call {
+	return &call{r: r, id: callID.Add(1)}
+}
+
+func (c *call) log(typ blpb.GrpcLogEntry_EventType, e *blpb.GrpcLogEntry) {
+	e.Timestamp = timestamppb.New(time.Now())
+	e.CallId = c.id
+	e.SequenceIdWithinCall = c.seq.Add(1)
+	e.Type = typ
+	e.Logger = blpb.GrpcLogEntry_LOGGER_CLIENT
+	c.r.write(e)
+}
+
+func (c *call) header(ctx context.Context, target, method string) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	h := &blpb.ClientHeader{
+		Metadata:   recordMetadata(md),
+		MethodName: method,
+		Authority:  target,
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		h.Timeout = durationpb.New(time.Until(deadline))
+	}
+	c.log(blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HEADER, &blpb.GrpcLogEntry{
+		Payload: &blpb.GrpcLogEntry_ClientHeader{ClientHeader: h},
+	})
+}
+
+func (c *call) message(typ blpb.GrpcLogEntry_EventType, msg any) {
+	data, truncated, length := c.r.messageData(msg)
+	c.log(typ, &blpb.GrpcLogEntry{
+		Payload:          &blpb.GrpcLogEntry_Message{Message: &blpb.Message{Length: length, Data: data}},
+		PayloadTruncated: truncated,
+	})
+}
+
+// trailer records the end of the call; only the first end is recorded.
+func (c *call) trailer(err error) {
+	if !c.logged.CompareAndSwap(false, true) {
+		return
+	}
+	s := status.Convert(err)
+	c.log(blpb.GrpcLogEntry_EVENT_TYPE_SERVER_TRAILER, &blpb.GrpcLogEntry{
+		Payload: &blpb.GrpcLogEntry_Trailer{Trailer: &blpb.Trailer{
+			StatusCode:    uint32(s.Code()),
+			StatusMessage: s.Message(),
+		}},
+	})
+}
+
+// messageData returns the serialized message with its credentials redacted, and
+// whether it was truncated, and its full length.
+func (r *recorder) messageData(msg any) (data []byte, truncated bool, length uint32) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil, true, 0
+	}
+	data, err := proto.Marshal(redact(m))
+	if err != nil {
+		return nil, true, 0
+	}
+	length = uint32(len(data))
+	if len(data) > r.maxMsgSize {
+		return data[:r.maxMsgSize], true, length
+	}
+	return data, false, length
+}
+
+func (r *recorder) unaryInterceptor(target string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		c := r.newCall()
+		c.header(ctx, target, method)
+		c.message(blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE, req)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil {
+			c.message(blpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE, reply)
+		}
+		c.trailer(err)
+		return err
+	}
+}
+
+func (r *recorder) streamInterceptor(target string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		c := r.newCall()
+		c.header(ctx, target, method)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			c.trailer(err)
+			return nil, err
+		}
+		return &recordedStream{ClientStream: cs, c: c}, nil
+	}
+}
+
+// recordedStream records the messages of a client stream.
+type recordedStream struct {
+	grpc.ClientStream
+	c *call
+}
+
+func (s *recordedStream) SendMsg(m any) error {
+	err := s.ClientStream.SendMsg(m)
+	if err == nil {
+		s.c.message(blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE, m)
+	}
+	return err
+}
+
+func (s *recordedStream) CloseSend() error {
+	err := s.ClientStream.CloseSend()
+	s.c.log(blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HALF_CLOSE, &blpb.GrpcLogEntry{})
+	return err
+}
+
+func (s *recordedStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err == nil:
+		s.c.message(blpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE, m)
+	case errors.Is(err, io.EOF):
+		s.c.trailer(nil)
+	default:
+		s.c.trailer(err)
+	}
+	return err
+}
+
+// secretName matches the names of metadata keys and message fields that hold
+// credentials.  All the string and bytes leaves under a matching message field are
+// redacted, e.g. the plaintext of a gNSI credentialz password.
+var secretName = regexp.MustCompile(`(?i)password|secret|token|authorization|private_key|username`)
+
+// secretFields are the fields that hold credentials regardless of their names.
+var secretFields = map[protoreflect.FullName]bool{
+	"gnsi.credentialz.v1.PasswordRequest.Password.plaintext": true,
+	"gnsi.certz.v1.Certificate.private_key":                  true,
+	"gnsi.certz.v1.Certificate.raw_private_key":              true,
+	"gnoi.certificate.KeyPair.private_key":                   true,
+}
+
+// isSecret returns whether the field holds credentials.
+func isSecret(fd protoreflect.FieldDescriptor) bool {
+	return secretFields[fd.FullName()] || secretName.MatchString(string(fd.Name()))
+}
+
+const redacted = "<redacted>"
+
+func recordMetadata(md metadata.MD) *blpb.Metadata {
+	if len(md) == 0 {
+		return nil
+	}
+	m := &blpb.Metadata{}
+	for k, vs := range md {
+		for _, v := range vs {
+			if secretName.MatchString(k) {
+				v = redacted
+			}
+			m.Entry = append(m.Entry, &blpb.MetadataEntry{Key: k, Value: []byte(v)})
+		}
+	}
+	return m
+}
+
+// redact returns the message with the values of the fields that hold credentials
+// replaced.  The message is only copied if it has such fields.
+func redact(m proto.Message) proto.Message {
+	if !scrub(m.ProtoReflect(), false, false) {
+		return m
+	}
+	m = proto.Clone(m)
+	scrub(m.ProtoReflect(), true, false)
+	return m
+}
+
+// scrub reports whether the message has string or bytes fields that hold
+// credentials, replacing their values if replace is true.  If secret is true, the
+// message is under a field that holds credentials, and all of its string and bytes
+// fields are treated as credentials.
+func scrub(m protoreflect.Message, replace, secret bool) bool {
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		secret := secret || isSecret(fd)
+		switch {
+		case fd.IsMap():
+			switch kind := fd.MapValue().Kind(); {
+			case kind == protoreflect.MessageKind:
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					found = scrub(mv.Message(), replace, secret) || found
+					return true
+				})
+			case (kind == protoreflect.StringKind || kind == protoreflect.BytesKind) && secret && v.Map().Len() > 0:
+				found = true
+				if !replace {
+					return true
+				}
+				v.Map().Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+					v.Map().Set(k, redactedValue(fd.MapValue()))
+					return true
+				})
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if fd.IsList() {
+				for i := 0; i < v.List().Len(); i++ {
+					found = scrub(v.List().Get(i).Message(), replace, secret) || found
+				}
+			} else {
+				found = scrub(v.Message(), replace, secret) || found
+			}
+		case (fd.Kind() == protoreflect.StringKind || fd.Kind() == protoreflect.BytesKind) && secret:
+			found = true
+			if !replace {
+				return true
+			}
+			if !fd.IsList() {
+				m.Set(fd, redactedValue(fd))
+				return true
+			}
+			for i := 0; i < v.List().Len(); i++ {
+				v.List().Set(i, redactedValue(fd))
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// redactedValue returns the value replacing a credential in a string or bytes field.
+func redactedValue(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	if fd.Kind() == protoreflect.StringKind {
+		return protoreflect.ValueOfString(redacted)
+	}
+	return protoreflect.ValueOfBytes([]byte(redacted))
+}
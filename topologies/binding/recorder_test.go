@@ -0,0 +1,355 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	credpb "github.com/openconfig/gnsi/credentialz"
+	blpb "google.golang.org/grpc/binarylog/grpc_binarylog_v1"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func readRecord(t *testing.T, file string) []*blpb.GrpcLogEntry {
+	t.Helper()
+	f, err := os.Open(file)
+	if err != nil {
+		t.Fatalf("Cannot open record: %v", err)
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	var entries []*blpb.GrpcLogEntry
+	for {
+		e := &blpb.GrpcLogEntry{}
+		if err := protodelim.UnmarshalFrom(r, e); err != nil {
+			if errors.Is(err, io.EOF) {
+				return entries
+			}
+			t.Fatalf("Cannot read record: %v", err)
+		}
+		entries = append(entries, e)
+	}
+}
+
+func entryTypes(entries []*blpb.GrpcLogEntry) []blpb.GrpcLogEntry_EventType {
+	var types []blpb.GrpcLogEntry_EventType
+	for _, e := range entries {
+		types = append(types, e.GetType())
+	}
+	return types
+}
+
+func TestRecorderUnary(t *testing.T) {
+	file := filepath.Join(t.TempDir(), recordFilename("dut:9339"))
+	r := newRecorder(file, 1<<20, 1<<10)
+	intercept := r.unaryInterceptor("dut:9339")
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "username", "admin", "x-trace", "abc")
+	req := &bindpb.Device{Name: "dut", Options: &bindpb.Options{Username: "admin", Password: "hunter2", Target: "dut:9339"}}
+	invoker := func(_ context.Context, _ string, _, reply any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		reply.(*bindpb.Options).Target = "reply"
+		return nil
+	}
+	if err := intercept(ctx, "/gnmi.gNMI/Get", req, &bindpb.Options{}, nil, invoker); err != nil {
+		t.Fatalf("Interceptor failed: %v", err)
+	}
+	if req.GetOptions().GetPassword() != "hunter2" {
+		t.Errorf("Request was modified by the redaction: %v", req)
+	}
+	failing := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		return status.Error(codes.Unavailable, "down")
+	}
+	if err := intercept(ctx, "/gnmi.gNMI/Set", &bindpb.Options{}, &bindpb.Options{}, nil, failing); err == nil {
+		t.Fatalf("Interceptor did not return the error")
+	}
+
+	entries := readRecord(t, file)
+	wantTypes := []blpb.GrpcLogEntry_EventType{
+		blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HEADER,
+		blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE,
+		blpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE,
+		blpb.GrpcLogEntry_EVENT_TYPE_SERVER_TRAILER,
+		blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HEADER,
+		blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE,
+		blpb.GrpcLogEntry_EVENT_TYPE_SERVER_TRAILER,
+	}
+	if diff := cmp.Diff(wantTypes, entryTypes(entries)); diff != "" {
+		t.Fatalf("Recorded event types differ (-want +got):\n%s", diff)
+	}
+
+	header := entries[0].GetClientHeader()
+	if got, want := header.GetMethodName(), "/gnmi.gNMI/Get"; got != want {
+		t.Errorf("Method got %q, want %q", got, want)
+	}
+	if got, want := header.GetAuthority(), "dut:9339"; got != want {
+		t.Errorf("Authority got %q, want %q", got, want)
+	}
+	md := map[string]string{}
+	for _, e := range header.GetMetadata().GetEntry() {
+		md[e.GetKey()] = string(e.GetValue())
+	}
+	if diff := cmp.Diff(map[string]string{"username": redacted, "x-trace": "abc"}, md); diff != "" {
+		t.Errorf("Recorded metadata differs (-want +got):\n%s", diff)
+	}
+
+	gotReq := &bindpb.Device{}
+	if err := proto.Unmarshal(entries[1].GetMessage().GetData(), gotReq); err != nil {
+		t.Fatalf("Cannot unmarshal request: %v", err)
+	}
+	wantReq := &bindpb.Device{Name: "dut", Options: &bindpb.Options{Username: redacted, Password: redacted, Target: "dut:9339"}}
+	if diff := cmp.Diff(wantReq, gotReq, protocmp.Transform()); diff != "" {
+		t.Errorf("Recorded request differs (-want +got):\n%s", diff)
+	}
+
+	for i, e := range entries {
+		if i < 4 && e.GetCallId() != entries[0].GetCallId() || i >= 4 && e.GetCallId() == entries[0].GetCallId() {
+			t.Errorf("Entry %d has call ID %d, first call ID is %d", i, e.GetCallId(), entries[0].GetCallId())
+		}
+		if e.GetTimestamp() == nil {
+			t.Errorf("Entry %d has no timestamp", i)
+		}
+	}
+	if got, want := codes.Code(entries[6].GetTrailer().GetStatusCode()), codes.Unavailable; got != want {
+		t.Errorf("Status code got %v, want %v", got, want)
+	}
+}
+
+type fakeStream struct {
+	grpc.ClientStream
+	recv []string
+}
+
+func (s *fakeStream) SendMsg(any) error { return nil }
+func (s *fakeStream) CloseSend() error  { return nil }
+
+func (s *fakeStream) RecvMsg(m any) error {
+	if len(s.recv) == 0 {
+		return io.EOF
+	}
+	m.(*bindpb.Options).Target, s.recv = s.recv[0], s.recv[1:]
+	return nil
+}
+
+func TestRecorderStream(t *testing.T) {
+	file := filepath.Join(t.TempDir(), recordFilename("dut:9339"))
+	r := newRecorder(file, 1<<20, 8)
+	streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+		return &fakeStream{recv: []string{"a", "a-much-longer-target"}}, nil
+	}
+	cs, err := r.streamInterceptor("dut:9339")(context.Background(), &grpc.StreamDesc{}, nil, "/gnmi.gNMI/Subscribe", streamer)
+	if err != nil {
+		t.Fatalf("Interceptor failed: %v", err)
+	}
+	if err := cs.SendMsg(&bindpb.Options{Target: "x"}); err != nil {
+		t.Fatalf("SendMsg failed: %v", err)
+	}
+	if err := cs.CloseSend(); err != nil {
+		t.Fatalf("CloseSend failed: %v", err)
+	}
+	for {
+		if err := cs.RecvMsg(&bindpb.Options{}); err != nil {
+			break
+		}
+	}
+
+	entries := readRecord(t, file)
+	wantTypes := []blpb.GrpcLogEntry_EventType{
+		blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HEADER,
+		blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE,
+		blpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HALF_CLOSE,
+		blpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE,
+		blpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE,
+		blpb.GrpcLogEntry_EVENT_TYPE_SERVER_TRAILER,
+	}
+	if diff := cmp.Diff(wantTypes, entryTypes(entries)); diff != "" {
+		t.Fatalf("Recorded event types differ (-want +got):\n%s", diff)
+	}
+	if entries[3].GetPayloadTruncated() {
+		t.Errorf("Short message was truncated")
+	}
+	long := entries[4]
+	if !long.GetPayloadTruncated() || len(long.GetMessage().GetData()) != 8 || long.GetMessage().GetLength() <= 8 {
+		t.Errorf("Long message not truncated to 8 bytes: truncated=%v, data=%d bytes, length=%d", long.GetPayloadTruncated(), len(long.GetMessage().GetData()), long.GetMessage().GetLength())
+	}
+	for i, e := range entries {
+		if got, want := e.GetSequenceIdWithinCall(), uint64(i+1); got != want {
+			t.Errorf("Entry %d has sequence ID %d, want %d", i, got, want)
+		}
+	}
+}
+
+func TestRecorderMaxBytes(t *testing.T) {
+	file := filepath.Join(t.TempDir(), recordFilename("dut:9339"))
+	r := newRecorder(file, 200, 1<<10)
+	intercept := r.unaryInterceptor("dut:9339")
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error { return nil }
+	for i := 0; i < 10; i++ {
+		if err := intercept(context.Background(), "/gnmi.gNMI/Get", &bindpb.Options{Target: "dut:9339"}, &bindpb.Options{}, nil, invoker); err != nil {
+			t.Fatalf("Interceptor failed: %v", err)
+		}
+	}
+	fi, err := os.Stat(file)
+	if err != nil {
+		t.Fatalf("Cannot stat record: %v", err)
+	}
+	if fi.Size() > 200 {
+		t.Errorf("Record has %d bytes, want at most 200", fi.Size())
+	}
+	if entries := readRecord(t, file); len(entries) == 0 {
+		t.Errorf("Record is empty")
+	}
+}
+
+func TestRedactRepeated(t *testing.T) {
+	repeated := func(name string, typ dpb.FieldDescriptorProto_Type, number int32) *dpb.FieldDescriptorProto {
+		return &dpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  dpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+			Type:   typ.Enum(),
+		}
+	}
+	fd, err := protodesc.NewFile(&dpb.FileDescriptorProto{
+		Name:    proto.String("redact_test.proto"),
+		Package: proto.String("redacttest"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*dpb.DescriptorProto{{
+			Name: proto.String("Credentials"),
+			Field: []*dpb.FieldDescriptorProto{
+				repeated("tokens", dpb.FieldDescriptorProto_TYPE_STRING, 1),
+				repeated("private_keys", dpb.FieldDescriptorProto_TYPE_BYTES, 2),
+				repeated("names", dpb.FieldDescriptorProto_TYPE_STRING, 3),
+			},
+		}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Cannot build descriptor: %v", err)
+	}
+	md := fd.Messages().ByName("Credentials")
+	m := dynamicpb.NewMessage(md)
+	set := func(name string, values ...protoreflect.Value) {
+		l := m.Mutable(md.Fields().ByName(protoreflect.Name(name))).List()
+		for _, v := range values {
+			l.Append(v)
+		}
+	}
+	set("tokens", protoreflect.ValueOfString("t1"), protoreflect.ValueOfString("t2"))
+	set("private_keys", protoreflect.ValueOfBytes([]byte("k1")))
+	set("names", protoreflect.ValueOfString("n1"))
+
+	got := redact(m).ProtoReflect()
+	list := func(name string) []string {
+		var ss []string
+		l := got.Get(md.Fields().ByName(protoreflect.Name(name))).List()
+		for i := 0; i < l.Len(); i++ {
+			switch v := l.Get(i).Interface().(type) {
+			case []byte:
+				ss = append(ss, string(v))
+			default:
+				ss = append(ss, l.Get(i).String())
+			}
+		}
+		return ss
+	}
+	if diff := cmp.Diff([]string{redacted, redacted}, list("tokens")); diff != "" {
+		t.Errorf("Redacted tokens differ (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{redacted}, list("private_keys")); diff != "" {
+		t.Errorf("Redacted private_keys differ (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"n1"}, list("names")); diff != "" {
+		t.Errorf("Names differ (-want +got):\n%s", diff)
+	}
+	if got := m.Get(md.Fields().ByName("tokens")).List().Get(0).String(); got != "t1" {
+		t.Errorf("Message was modified by the redaction: tokens[0] is %q", got)
+	}
+}
+
+func TestRecorderCredentialz(t *testing.T) {
+	file := filepath.Join(t.TempDir(), recordFilename("dut:9339"))
+	r := newRecorder(file, 1<<20, 1<<10)
+	streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+		return &fakeStream{}, nil
+	}
+	cs, err := r.streamInterceptor("dut:9339")(context.Background(), &grpc.StreamDesc{}, nil, "/gnsi.credentialz.v1.Credentialz/RotateAccountCredentials", streamer)
+	if err != nil {
+		t.Fatalf("Interceptor failed: %v", err)
+	}
+	req := &credpb.RotateAccountCredentialsRequest{
+		Request: &credpb.RotateAccountCredentialsRequest_Password{
+			Password: &credpb.PasswordRequest{
+				Accounts: []*credpb.PasswordRequest_Account{{
+					Account: "admin",
+					Password: &credpb.PasswordRequest_Password{
+						Value: &credpb.PasswordRequest_Password_Plaintext{Plaintext: "hunter2"},
+					},
+				}},
+			},
+		},
+	}
+	if err := cs.SendMsg(req); err != nil {
+		t.Fatalf("SendMsg failed: %v", err)
+	}
+	if err := cs.CloseSend(); err != nil {
+		t.Fatalf("CloseSend failed: %v", err)
+	}
+
+	entries := readRecord(t, file)
+	if len(entries) < 2 {
+		t.Fatalf("Got %d recorded entries, want at least 2", len(entries))
+	}
+	data := entries[1].GetMessage().GetData()
+	if bytes.Contains(data, []byte("hunter2")) {
+		t.Errorf("Recorded request contains the plaintext password")
+	}
+	got := &credpb.RotateAccountCredentialsRequest{}
+	if err := proto.Unmarshal(data, got); err != nil {
+		t.Fatalf("Cannot unmarshal request: %v", err)
+	}
+	if got, want := got.GetPassword().GetAccounts()[0].GetPassword().GetPlaintext(), redacted; got != want {
+		t.Errorf("Recorded plaintext got %q, want %q", got, want)
+	}
+	if got := req.GetPassword().GetAccounts()[0].GetPassword().GetPlaintext(); got != "hunter2" {
+		t.Errorf("Request was modified by the redaction: plaintext is %q", got)
+	}
+
+	// The plaintext is redacted even without a parent field named like a credential.
+	pw := redact(&credpb.PasswordRequest_Password{
+		Value: &credpb.PasswordRequest_Password_Plaintext{Plaintext: "hunter2"},
+	}).(*credpb.PasswordRequest_Password)
+	if got, want := pw.GetPlaintext(), redacted; got != want {
+		t.Errorf("redact(Password) plaintext got %q, want %q", got, want)
+	}
+}
@@ -15,15 +15,54 @@
 package binding
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/value"
 	spb "github.com/openconfig/gribi/v1/proto/service"
+	"github.com/openconfig/ygot/ygot"
 	"google.golang.org/protobuf/encoding/prototext"
 )
 
+// resetResult is the outcome of the reset of a DUT.
+type resetResult struct {
+	dut      string
+	duration time.Duration
+	err      error
+}
+
+// resetDUTs resets the DUTs with resetFn, running at most workers resets at a time.
+// The results are in the order of the DUTs.
+func resetDUTs(ctx context.Context, duts []*staticDUT, workers int, resetFn func(context.Context, *staticDUT) error) []resetResult {
+	if workers < 1 {
+		workers = 1
+	}
+	results := make([]resetResult, len(duts))
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i, dut := range duts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			start := time.Now()
+			err := resetFn(ctx, dut)
+			results[i] = resetResult{dut: dut.Name(), duration: time.Since(start), err: err}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
 func readCLI(path string) (string, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -120,3 +159,81 @@ func resetGRIBI(ctx context.Context, dut *staticDUT) error {
 	_, err = gribi.Flush(ctx, req)
 	return err
 }
+
+// verifyReset checks that the leaves to verify after the reset have their expected
+// values.
+func verifyReset(ctx context.Context, dut *staticDUT) error {
+	verify := dut.dev.GetConfig().GetVerify()
+	if len(verify) == 0 {
+		return nil
+	}
+
+	gnmi, err := dut.DialGNMI(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, leaf := range verify {
+		path, err := ygot.StringToStructuredPath(leaf.GetPath())
+		if err != nil {
+			return fmt.Errorf("invalid path %q to verify: %w", leaf.GetPath(), err)
+		}
+		resp, err := gnmi.Get(ctx, &gpb.GetRequest{
+			Path:     []*gpb.Path{path},
+			Encoding: gpb.Encoding_JSON_IETF,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not get %s: %w", leaf.GetPath(), err))
+			continue
+		}
+		got, err := leafValue(resp)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not read %s: %w", leaf.GetPath(), err))
+			continue
+		}
+		if got != leaf.GetValue() {
+			errs = append(errs, fmt.Errorf("%s is %q after reset, want %q", leaf.GetPath(), got, leaf.GetValue()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// leafValue returns the value of the single leaf in a GetResponse as a string.
+func leafValue(resp *gpb.GetResponse) (string, error) {
+	var updates []*gpb.Update
+	for _, n := range resp.GetNotification() {
+		updates = append(updates, n.GetUpdate()...)
+	}
+	if len(updates) != 1 {
+		return "", fmt.Errorf("got %d values, want 1", len(updates))
+	}
+	return typedValueString(updates[0].GetVal())
+}
+
+// typedValueString returns a gNMI value as a string.  JSON values other than
+// strings are returned in their compact form.
+func typedValueString(tv *gpb.TypedValue) (string, error) {
+	var b []byte
+	switch v := tv.GetValue().(type) {
+	case *gpb.TypedValue_JsonIetfVal:
+		b = v.JsonIetfVal
+	case *gpb.TypedValue_JsonVal:
+		b = v.JsonVal
+	default:
+		s, err := value.ToScalar(tv)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint(s), nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		return s, nil
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResetDUTs(t *testing.T) {
+	const workers = 2
+	var duts []*staticDUT
+	for i := 0; i < 5; i++ {
+		duts = append(duts, &staticDUT{
+			AbstractDUT: &binding.AbstractDUT{Dims: &binding.Dims{Name: fmt.Sprintf("dut%d", i)}},
+		})
+	}
+
+	var mu sync.Mutex
+	var running, maxRunning int
+	results := resetDUTs(context.Background(), duts, workers, func(_ context.Context, d *staticDUT) error {
+		mu.Lock()
+		running++
+		maxRunning = max(maxRunning, running)
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		if d.Name() == "dut3" {
+			return errors.New("no luck")
+		}
+		return nil
+	})
+
+	if maxRunning > workers {
+		t.Errorf("resetDUTs() ran %d resets at once, want at most %d", maxRunning, workers)
+	}
+	if len(results) != len(duts) {
+		t.Fatalf("resetDUTs() got %d results, want %d", len(results), len(duts))
+	}
+	for i, res := range results {
+		if got, want := res.dut, duts[i].Name(); got != want {
+			t.Errorf("resetDUTs() result %d is for %s, want %s", i, got, want)
+		}
+		if res.duration <= 0 {
+			t.Errorf("resetDUTs() result of %s has duration %v, want > 0", res.dut, res.duration)
+		}
+		if gotErr, wantErr := res.err != nil, res.dut == "dut3"; gotErr != wantErr {
+			t.Errorf("resetDUTs() result of %s got err %v, want err: %t", res.dut, res.err, wantErr)
+		}
+	}
+}
+
+// leafServer is a gNMI server that serves the leaves in its map, keyed by path.
+type leafServer struct {
+	gpb.UnimplementedGNMIServer
+	leaves map[string]*gpb.TypedValue
+}
+
+func (s *leafServer) Get(_ context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+	resp := &gpb.GetResponse{}
+	for _, p := range req.GetPath() {
+		ps, err := ygot.PathToString(p)
+		if err != nil {
+			return nil, err
+		}
+		val, ok := s.leaves[ps]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "no leaf %s", ps)
+		}
+		resp.Notification = append(resp.Notification, &gpb.Notification{
+			Update: []*gpb.Update{{Path: p, Val: val}},
+		})
+	}
+	return resp, nil
+}
+
+func TestVerifyReset(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Could not listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	gpb.RegisterGNMIServer(srv, &leafServer{leaves: map[string]*gpb.TypedValue{
+		"/system/config/hostname":                     {Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`"dut1"`)}},
+		"/system/config/domain-name":                  {Value: &gpb.TypedValue_StringVal{StringVal: "example.com"}},
+		"/interfaces/interface[name=eth1]/config/mtu": {Value: &gpb.TypedValue_UintVal{UintVal: 9000}},
+	}})
+	go srv.Serve(l)
+	defer srv.Stop()
+
+	origDial := grpcDialContextFn
+	grpcDialContextFn = grpc.NewClient
+	defer func() { grpcDialContextFn = origDial }()
+
+	newDUT := func(verify ...*bindpb.LeafValue) *staticDUT {
+		return &staticDUT{
+			r: resolver{&bindpb.Binding{}},
+			dev: &bindpb.Device{
+				Gnmi:   &bindpb.Options{Target: l.Addr().String(), Insecure: true},
+				Config: &bindpb.Configs{Verify: verify},
+			},
+		}
+	}
+
+	t.Run("match", func(t *testing.T) {
+		d := newDUT(
+			&bindpb.LeafValue{Path: "/system/config/hostname", Value: "dut1"},
+			&bindpb.LeafValue{Path: "/system/config/domain-name", Value: "example.com"},
+			&bindpb.LeafValue{Path: "/interfaces/interface[name=eth1]/config/mtu", Value: "9000"},
+		)
+		if err := verifyReset(context.Background(), d); err != nil {
+			t.Errorf("verifyReset() got err: %v", err)
+		}
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		d := newDUT(
+			&bindpb.LeafValue{Path: "/system/config/hostname", Value: "dut2"},
+			&bindpb.LeafValue{Path: "/system/config/login-banner", Value: "hi"},
+		)
+		err := verifyReset(context.Background(), d)
+		if err == nil {
+			t.Fatalf("verifyReset() got no error, want error")
+		}
+		for _, want := range []string{`/system/config/hostname is "dut1" after reset, want "dut2"`, "could not get /system/config/login-banner"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("verifyReset() got err %q, want it to contain %q", err, want)
+			}
+		}
+	})
+}
+
+func TestTypedValueString(t *testing.T) {
+	tests := []struct {
+		desc string
+		tv   *gpb.TypedValue
+		want string
+	}{{
+		desc: "string",
+		tv:   &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "foo"}},
+		want: "foo",
+	}, {
+		desc: "bool",
+		tv:   &gpb.TypedValue{Value: &gpb.TypedValue_BoolVal{BoolVal: true}},
+		want: "true",
+	}, {
+		desc: "json string",
+		tv:   &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`"foo"`)}},
+		want: "foo",
+	}, {
+		desc: "json number",
+		tv:   &gpb.TypedValue{Value: &gpb.TypedValue_JsonVal{JsonVal: []byte(` 9000 `)}},
+		want: "9000",
+	}, {
+		desc: "json object",
+		tv:   &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"a": [1, 2]}`)}},
+		want: `{"a":[1,2]}`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := typedValueString(tt.tv)
+			if err != nil {
+				t.Fatalf("typedValueString() got err: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("typedValueString() got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
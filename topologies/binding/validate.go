@@ -0,0 +1,145 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// ValidateTestbed checks that the static binding given by -binding provides every
+// device and port of the testbed file, so that a mismatch is reported before the
+// reservation is attempted.  It does nothing if there is no static binding or if the
+// binding is solved dynamically.
+func ValidateTestbed(testbedFile string) error {
+	if *bindingFile == "" || testbedFile == "" {
+		return nil
+	}
+	b := &bindpb.Binding{}
+	if err := readText(*bindingFile, b); err != nil {
+		return fmt.Errorf("unable to read binding file: %w", err)
+	}
+	tb := &opb.Testbed{}
+	if err := readText(testbedFile, tb); err != nil {
+		return fmt.Errorf("unable to read testbed file: %w", err)
+	}
+	if err := CheckTestbed(tb, b); err != nil {
+		return fmt.Errorf("binding %s does not satisfy testbed %s:\n%w", *bindingFile, testbedFile, err)
+	}
+	return nil
+}
+
+func readText(file string, m proto.Message) error {
+	in, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	return prototext.Unmarshal(in, m)
+}
+
+// CheckTestbed returns an error if the binding does not provide every device and port
+// that the testbed needs.  The error lists the devices and ports of the testbed, with
+// those missing from the binding marked by "-".  Dynamic bindings are not checked,
+// since their devices and ports are only assigned by the reservation.
+func CheckTestbed(tb *opb.Testbed, b *bindpb.Binding) error {
+	if b.GetDynamic() {
+		return nil
+	}
+	c := compareDevices(tb, b)
+	if c.missing == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d devices or ports missing (-testbed only):\n%s", c.missing, strings.Join(c.diff, "\n"))
+}
+
+// coverage is the result of matching the devices and ports of a testbed with those of
+// a static binding by ID.
+type coverage struct {
+	// diff lists the devices and ports of the testbed, with those missing from the
+	// binding marked by "-".
+	diff []string
+	// missing is the number of devices and ports of the testbed missing from the binding.
+	missing int
+	// unused are the devices and ports of the binding that the testbed does not use.
+	unused []error
+}
+
+func compareDevices(tb *opb.Testbed, b *bindpb.Binding) *coverage {
+	c := &coverage{}
+	c.add("DUT", tb.GetDuts(), b.GetDuts())
+	c.add("ATE", tb.GetAtes(), b.GetAtes())
+	return c
+}
+
+func (c *coverage) add(kind string, tdevs []*opb.Device, bdevs []*bindpb.Device) {
+	bdevMap := make(map[string]*bindpb.Device)
+	for _, bdev := range bdevs {
+		bdevMap[bdev.GetId()] = bdev
+	}
+	tdevMap := make(map[string]*opb.Device)
+	for _, tdev := range tdevs {
+		tdevMap[tdev.GetId()] = tdev
+	}
+
+	for _, tdev := range tdevs {
+		bdev, ok := bdevMap[tdev.GetId()]
+		c.diff = append(c.diff, diffLine(ok, fmt.Sprintf("%s %s", strings.ToLower(kind), tdev.GetId())))
+		if !ok {
+			c.missing++
+		}
+		bports := make(map[string]bool)
+		for _, bport := range bdev.GetPorts() {
+			bports[bport.GetId()] = true
+		}
+		for _, tport := range tdev.GetPorts() {
+			ok := bports[tport.GetId()]
+			c.diff = append(c.diff, diffLine(ok, fmt.Sprintf("  %s:%s", tdev.GetId(), tport.GetId())))
+			if !ok {
+				c.missing++
+			}
+		}
+	}
+
+	for _, bdev := range bdevs {
+		tdev, ok := tdevMap[bdev.GetId()]
+		if !ok {
+			c.unused = append(c.unused, fmt.Errorf("%s %q is not used by the testbed", kind, bdev.GetId()))
+			continue
+		}
+		tports := make(map[string]bool)
+		for _, tport := range tdev.GetPorts() {
+			tports[tport.GetId()] = true
+		}
+		for _, bport := range bdev.GetPorts() {
+			if !tports[bport.GetId()] {
+				c.unused = append(c.unused, fmt.Errorf("port %q (%s) on %q is not used by the testbed", bport.GetId(), bport.GetName(), bdev.GetId()))
+			}
+		}
+	}
+}
+
+func diffLine(bound bool, s string) string {
+	if bound {
+		return "  " + s
+	}
+	return "- " + s
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func TestCheckTestbed(t *testing.T) {
+	tb := &opb.Testbed{
+		Duts: []*opb.Device{{
+			Id:    "dut",
+			Ports: []*opb.Port{{Id: "port1"}, {Id: "port2"}},
+		}, {
+			Id:    "dut2",
+			Ports: []*opb.Port{{Id: "port1"}},
+		}},
+		Ates: []*opb.Device{{
+			Id:    "ate",
+			Ports: []*opb.Port{{Id: "port1"}},
+		}},
+	}
+
+	tests := []struct {
+		desc string
+		b    *bindpb.Binding
+		want string
+	}{{
+		desc: "satisfied",
+		b: &bindpb.Binding{
+			Duts: []*bindpb.Device{{
+				Id:    "dut",
+				Ports: []*bindpb.Port{{Id: "port1"}, {Id: "port2"}, {Id: "port3"}},
+			}, {
+				Id:    "dut2",
+				Ports: []*bindpb.Port{{Id: "port1"}},
+			}},
+			Ates: []*bindpb.Device{{
+				Id:    "ate",
+				Ports: []*bindpb.Port{{Id: "port1"}},
+			}},
+		},
+	}, {
+		desc: "dynamic",
+		b:    &bindpb.Binding{Dynamic: true},
+	}, {
+		desc: "missing",
+		b: &bindpb.Binding{
+			Duts: []*bindpb.Device{{
+				Id:    "dut",
+				Ports: []*bindpb.Port{{Id: "port1"}},
+			}},
+			Ates: []*bindpb.Device{{
+				Id:    "ate",
+				Ports: []*bindpb.Port{{Id: "port1"}},
+			}},
+		},
+		want: `3 devices or ports missing (-testbed only):
+  dut dut
+    dut:port1
+-   dut:port2
+- dut dut2
+-   dut2:port1
+  ate ate
+    ate:port1`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var got string
+			if err := CheckTestbed(tb, test.b); err != nil {
+				got = err.Error()
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("CheckTestbed() got unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
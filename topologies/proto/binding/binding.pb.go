@@ -128,7 +128,10 @@ type Configs struct {
 	GnmiSetFile []string `protobuf:"bytes,3,rep,name=gnmi_set_file,json=gnmiSetFile,proto3" json:"gnmi_set_file,omitempty"`
 	// Whether to flush gRIBI.  If true, this will send a FlushRequest for all
 	// network instances and overriding the election ID.
-	GribiFlush    bool `protobuf:"varint,4,opt,name=gribi_flush,json=gribiFlush,proto3" json:"gribi_flush,omitempty"`
+	GribiFlush bool `protobuf:"varint,4,opt,name=gribi_flush,json=gribiFlush,proto3" json:"gribi_flush,omitempty"`
+	// Leaves to check with gNMI once the device is reset.  The reset fails
+	// if any leaf does not have its expected value.
+	Verify        []*LeafValue `protobuf:"bytes,5,rep,name=verify,proto3" json:"verify,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -191,6 +194,69 @@ func (x *Configs) GetGribiFlush() bool {
 	return false
 }
 
+func (x *Configs) GetVerify() []*LeafValue {
+	if x != nil {
+		return x.Verify
+	}
+	return nil
+}
+
+// The expected value of a leaf.
+type LeafValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// gNMI path of the leaf, e.g. "/system/config/hostname".
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The expected value, as a string.  A value encoded as JSON by the device
+	// is compared in its compact JSON form, unless it is a JSON string.
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeafValue) Reset() {
+	*x = LeafValue{}
+	mi := &file_binding_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeafValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeafValue) ProtoMessage() {}
+
+func (x *LeafValue) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeafValue.ProtoReflect.Descriptor instead.
+func (*LeafValue) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LeafValue) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *LeafValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 // A device binding.
 type Device struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -235,7 +301,7 @@ type Device struct {
 
 func (x *Device) Reset() {
 	*x = Device{}
-	mi := &file_binding_proto_msgTypes[2]
+	mi := &file_binding_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -247,7 +313,7 @@ func (x *Device) String() string {
 func (*Device) ProtoMessage() {}
 
 func (x *Device) ProtoReflect() protoreflect.Message {
-	mi := &file_binding_proto_msgTypes[2]
+	mi := &file_binding_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,7 +326,7 @@ func (x *Device) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Device.ProtoReflect.Descriptor instead.
 func (*Device) Descriptor() ([]byte, []int) {
-	return file_binding_proto_rawDescGZIP(), []int{2}
+	return file_binding_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Device) GetId() string {
@@ -382,7 +448,8 @@ func (x *Device) GetGnpsi() *Options {
 	return nil
 }
 
-// Dial options.
+// Dial options.  String options may refer to environment variables as
+// ${NAME}, which are expanded when the binding is loaded.
 type Options struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// This is the dial target, typically formatted as "hostname:port".
@@ -410,14 +477,34 @@ type Options struct {
 	// Certificate file path : a *.pem file that is signed by root or intermediate CA
 	CertFile string `protobuf:"bytes,11,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
 	// Key file Path: a *.pem file that contains a private key
-	KeyFile       string `protobuf:"bytes,12,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	KeyFile string `protobuf:"bytes,12,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// File containing the username, to use instead of username.  Trailing
+	// whitespace in the file is ignored.
+	UsernameFile string `protobuf:"bytes,13,opt,name=username_file,json=usernameFile,proto3" json:"username_file,omitempty"`
+	// File containing the password, to use instead of password.  Trailing
+	// whitespace in the file is ignored.
+	PasswordFile string `protobuf:"bytes,14,opt,name=password_file,json=passwordFile,proto3" json:"password_file,omitempty"`
+	// SSH jump host, formatted as "hostname:port", through which SSH
+	// sessions and gRPC connections to the device are tunneled.  The port
+	// defaults to 22.  Leave unset to connect to the device directly.
+	JumpHost string `protobuf:"bytes,15,opt,name=jump_host,json=jumpHost,proto3" json:"jump_host,omitempty"`
+	// The username on the jump host.
+	JumpUsername string `protobuf:"bytes,16,opt,name=jump_username,json=jumpUsername,proto3" json:"jump_username,omitempty"`
+	// The password on the jump host, if it does not accept jump_key_file.
+	JumpPassword string `protobuf:"bytes,17,opt,name=jump_password,json=jumpPassword,proto3" json:"jump_password,omitempty"`
+	// Private key file for authenticating with the jump host.
+	JumpKeyFile string `protobuf:"bytes,18,opt,name=jump_key_file,json=jumpKeyFile,proto3" json:"jump_key_file,omitempty"`
+	// known_hosts file to verify the jump host key.  If not set, the user
+	// and system known_hosts files are used.  The jump host key is always
+	// verified, regardless of skip_verify.
+	JumpKnownHostsFile string `protobuf:"bytes,19,opt,name=jump_known_hosts_file,json=jumpKnownHostsFile,proto3" json:"jump_known_hosts_file,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Options) Reset() {
 	*x = Options{}
-	mi := &file_binding_proto_msgTypes[3]
+	mi := &file_binding_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -429,7 +516,7 @@ func (x *Options) String() string {
 func (*Options) ProtoMessage() {}
 
 func (x *Options) ProtoReflect() protoreflect.Message {
-	mi := &file_binding_proto_msgTypes[3]
+	mi := &file_binding_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +529,7 @@ func (x *Options) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Options.ProtoReflect.Descriptor instead.
 func (*Options) Descriptor() ([]byte, []int) {
-	return file_binding_proto_rawDescGZIP(), []int{3}
+	return file_binding_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Options) GetTarget() string {
@@ -529,6 +616,55 @@ func (x *Options) GetKeyFile() string {
 	return ""
 }
 
+func (x *Options) GetUsernameFile() string {
+	if x != nil {
+		return x.UsernameFile
+	}
+	return ""
+}
+
+func (x *Options) GetPasswordFile() string {
+	if x != nil {
+		return x.PasswordFile
+	}
+	return ""
+}
+
+func (x *Options) GetJumpHost() string {
+	if x != nil {
+		return x.JumpHost
+	}
+	return ""
+}
+
+func (x *Options) GetJumpUsername() string {
+	if x != nil {
+		return x.JumpUsername
+	}
+	return ""
+}
+
+func (x *Options) GetJumpPassword() string {
+	if x != nil {
+		return x.JumpPassword
+	}
+	return ""
+}
+
+func (x *Options) GetJumpKeyFile() string {
+	if x != nil {
+		return x.JumpKeyFile
+	}
+	return ""
+}
+
+func (x *Options) GetJumpKnownHostsFile() string {
+	if x != nil {
+		return x.JumpKnownHostsFile
+	}
+	return ""
+}
+
 // Port binding.
 type Port struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -547,7 +683,7 @@ type Port struct {
 
 func (x *Port) Reset() {
 	*x = Port{}
-	mi := &file_binding_proto_msgTypes[4]
+	mi := &file_binding_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -559,7 +695,7 @@ func (x *Port) String() string {
 func (*Port) ProtoMessage() {}
 
 func (x *Port) ProtoReflect() protoreflect.Message {
-	mi := &file_binding_proto_msgTypes[4]
+	mi := &file_binding_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +708,7 @@ func (x *Port) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Port.ProtoReflect.Descriptor instead.
 func (*Port) Descriptor() ([]byte, []int) {
-	return file_binding_proto_rawDescGZIP(), []int{4}
+	return file_binding_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Port) GetId() string {
@@ -615,7 +751,7 @@ type Link struct {
 
 func (x *Link) Reset() {
 	*x = Link{}
-	mi := &file_binding_proto_msgTypes[5]
+	mi := &file_binding_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -627,7 +763,7 @@ func (x *Link) String() string {
 func (*Link) ProtoMessage() {}
 
 func (x *Link) ProtoReflect() protoreflect.Message {
-	mi := &file_binding_proto_msgTypes[5]
+	mi := &file_binding_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +776,7 @@ func (x *Link) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Link.ProtoReflect.Descriptor instead.
 func (*Link) Descriptor() ([]byte, []int) {
-	return file_binding_proto_rawDescGZIP(), []int{5}
+	return file_binding_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Link) GetA() string {
@@ -667,13 +803,17 @@ const file_binding_proto_rawDesc = "" +
 	"\x04ates\x18\x02 \x03(\v2\x1a.openconfig.testing.DeviceR\x04ates\x125\n" +
 	"\aoptions\x18\x03 \x01(\v2\x1b.openconfig.testing.OptionsR\aoptions\x12\x18\n" +
 	"\adynamic\x18\x04 \x01(\bR\adynamic\x12.\n" +
-	"\x05links\x18\x05 \x03(\v2\x18.openconfig.testing.LinkR\x05links\"{\n" +
+	"\x05links\x18\x05 \x03(\v2\x18.openconfig.testing.LinkR\x05links\"\xb2\x01\n" +
 	"\aConfigs\x12\x10\n" +
 	"\x03cli\x18\x01 \x03(\fR\x03cli\x12\x19\n" +
 	"\bcli_file\x18\x02 \x03(\tR\acliFile\x12\"\n" +
 	"\rgnmi_set_file\x18\x03 \x03(\tR\vgnmiSetFile\x12\x1f\n" +
 	"\vgribi_flush\x18\x04 \x01(\bR\n" +
-	"gribiFlush\"\x8d\x06\n" +
+	"gribiFlush\x125\n" +
+	"\x06verify\x18\x05 \x03(\v2\x1d.openconfig.testing.LeafValueR\x06verify\"5\n" +
+	"\tLeafValue\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x8d\x06\n" +
 	"\x06Device\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
@@ -691,7 +831,7 @@ const file_binding_proto_rawDesc = "" +
 	"\x06vendor\x18\x13 \x01(\x0e2\x16.ondatra.Device.VendorR\x06vendor\x12%\n" +
 	"\x0ehardware_model\x18\x14 \x01(\tR\rhardwareModel\x12)\n" +
 	"\x10software_version\x18\x15 \x01(\tR\x0fsoftwareVersion\x121\n" +
-	"\x05gnpsi\x18\x16 \x01(\v2\x1b.openconfig.testing.OptionsR\x05gnpsi\"\xfd\x02\n" +
+	"\x05gnpsi\x18\x16 \x01(\v2\x1b.openconfig.testing.OptionsR\x05gnpsi\"\x85\x05\n" +
 	"\aOptions\x12\x16\n" +
 	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1a\n" +
 	"\binsecure\x18\x02 \x01(\bR\binsecure\x12\x1f\n" +
@@ -708,7 +848,14 @@ const file_binding_proto_rawDesc = "" +
 	"\x11trust_bundle_file\x18\n" +
 	" \x01(\tR\x0ftrustBundleFile\x12\x1b\n" +
 	"\tcert_file\x18\v \x01(\tR\bcertFile\x12\x19\n" +
-	"\bkey_file\x18\f \x01(\tR\akeyFile\"z\n" +
+	"\bkey_file\x18\f \x01(\tR\akeyFile\x12#\n" +
+	"\rusername_file\x18\r \x01(\tR\fusernameFile\x12#\n" +
+	"\rpassword_file\x18\x0e \x01(\tR\fpasswordFile\x12\x1b\n" +
+	"\tjump_host\x18\x0f \x01(\tR\bjumpHost\x12#\n" +
+	"\rjump_username\x18\x10 \x01(\tR\fjumpUsername\x12#\n" +
+	"\rjump_password\x18\x11 \x01(\tR\fjumpPassword\x12\"\n" +
+	"\rjump_key_file\x18\x12 \x01(\tR\vjumpKeyFile\x121\n" +
+	"\x15jump_known_hosts_file\x18\x13 \x01(\tR\x12jumpKnownHostsFile\"z\n" +
 	"\x04Port\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
@@ -730,43 +877,45 @@ func file_binding_proto_rawDescGZIP() []byte {
 	return file_binding_proto_rawDescData
 }
 
-var file_binding_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_binding_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_binding_proto_goTypes = []any{
 	(*Binding)(nil),          // 0: openconfig.testing.Binding
 	(*Configs)(nil),          // 1: openconfig.testing.Configs
-	(*Device)(nil),           // 2: openconfig.testing.Device
-	(*Options)(nil),          // 3: openconfig.testing.Options
-	(*Port)(nil),             // 4: openconfig.testing.Port
-	(*Link)(nil),             // 5: openconfig.testing.Link
-	(proto.Device_Vendor)(0), // 6: ondatra.Device.Vendor
-	(proto.Port_Speed)(0),    // 7: ondatra.Port.Speed
-	(proto.Port_Pmd)(0),      // 8: ondatra.Port.Pmd
+	(*LeafValue)(nil),        // 2: openconfig.testing.LeafValue
+	(*Device)(nil),           // 3: openconfig.testing.Device
+	(*Options)(nil),          // 4: openconfig.testing.Options
+	(*Port)(nil),             // 5: openconfig.testing.Port
+	(*Link)(nil),             // 6: openconfig.testing.Link
+	(proto.Device_Vendor)(0), // 7: ondatra.Device.Vendor
+	(proto.Port_Speed)(0),    // 8: ondatra.Port.Speed
+	(proto.Port_Pmd)(0),      // 9: ondatra.Port.Pmd
 }
 var file_binding_proto_depIdxs = []int32{
-	2,  // 0: openconfig.testing.Binding.duts:type_name -> openconfig.testing.Device
-	2,  // 1: openconfig.testing.Binding.ates:type_name -> openconfig.testing.Device
-	3,  // 2: openconfig.testing.Binding.options:type_name -> openconfig.testing.Options
-	5,  // 3: openconfig.testing.Binding.links:type_name -> openconfig.testing.Link
-	3,  // 4: openconfig.testing.Device.options:type_name -> openconfig.testing.Options
-	4,  // 5: openconfig.testing.Device.ports:type_name -> openconfig.testing.Port
-	1,  // 6: openconfig.testing.Device.config:type_name -> openconfig.testing.Configs
-	3,  // 7: openconfig.testing.Device.ssh:type_name -> openconfig.testing.Options
-	3,  // 8: openconfig.testing.Device.gnmi:type_name -> openconfig.testing.Options
-	3,  // 9: openconfig.testing.Device.gnoi:type_name -> openconfig.testing.Options
-	3,  // 10: openconfig.testing.Device.gnsi:type_name -> openconfig.testing.Options
-	3,  // 11: openconfig.testing.Device.gribi:type_name -> openconfig.testing.Options
-	3,  // 12: openconfig.testing.Device.p4rt:type_name -> openconfig.testing.Options
-	3,  // 13: openconfig.testing.Device.ixnetwork:type_name -> openconfig.testing.Options
-	3,  // 14: openconfig.testing.Device.otg:type_name -> openconfig.testing.Options
-	6,  // 15: openconfig.testing.Device.vendor:type_name -> ondatra.Device.Vendor
-	3,  // 16: openconfig.testing.Device.gnpsi:type_name -> openconfig.testing.Options
-	7,  // 17: openconfig.testing.Port.speed:type_name -> ondatra.Port.Speed
-	8,  // 18: openconfig.testing.Port.pmd:type_name -> ondatra.Port.Pmd
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	3,  // 0: openconfig.testing.Binding.duts:type_name -> openconfig.testing.Device
+	3,  // 1: openconfig.testing.Binding.ates:type_name -> openconfig.testing.Device
+	4,  // 2: openconfig.testing.Binding.options:type_name -> openconfig.testing.Options
+	6,  // 3: openconfig.testing.Binding.links:type_name -> openconfig.testing.Link
+	2,  // 4: openconfig.testing.Configs.verify:type_name -> openconfig.testing.LeafValue
+	4,  // 5: openconfig.testing.Device.options:type_name -> openconfig.testing.Options
+	5,  // 6: openconfig.testing.Device.ports:type_name -> openconfig.testing.Port
+	1,  // 7: openconfig.testing.Device.config:type_name -> openconfig.testing.Configs
+	4,  // 8: openconfig.testing.Device.ssh:type_name -> openconfig.testing.Options
+	4,  // 9: openconfig.testing.Device.gnmi:type_name -> openconfig.testing.Options
+	4,  // 10: openconfig.testing.Device.gnoi:type_name -> openconfig.testing.Options
+	4,  // 11: openconfig.testing.Device.gnsi:type_name -> openconfig.testing.Options
+	4,  // 12: openconfig.testing.Device.gribi:type_name -> openconfig.testing.Options
+	4,  // 13: openconfig.testing.Device.p4rt:type_name -> openconfig.testing.Options
+	4,  // 14: openconfig.testing.Device.ixnetwork:type_name -> openconfig.testing.Options
+	4,  // 15: openconfig.testing.Device.otg:type_name -> openconfig.testing.Options
+	7,  // 16: openconfig.testing.Device.vendor:type_name -> ondatra.Device.Vendor
+	4,  // 17: openconfig.testing.Device.gnpsi:type_name -> openconfig.testing.Options
+	8,  // 18: openconfig.testing.Port.speed:type_name -> ondatra.Port.Speed
+	9,  // 19: openconfig.testing.Port.pmd:type_name -> ondatra.Port.Pmd
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_binding_proto_init() }
@@ -780,7 +929,7 @@ func file_binding_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_binding_proto_rawDesc), len(file_binding_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},